@@ -0,0 +1,151 @@
+package gopowerschool
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const defaultBatchWorkers = 4
+
+// BatchJob is one account to fetch with a BatchFetcher.
+type BatchJob struct {
+	Client      *PublicPortalServiceJSONPortType
+	Credentials CredentialProvider
+}
+
+// BatchResult is the outcome for one student of a BatchJob. Exactly one of
+// Student and Err is set. StudentID is 0 when the job failed before its
+// students were known, e.g. because the login was rejected.
+type BatchResult struct {
+	Job       BatchJob
+	StudentID int64
+	Student   *StudentDataVO
+	Err       error
+}
+
+// BatchFetcher fetches StudentDataVOs for many accounts concurrently. The zero
+// value uses four workers and no rate limit.
+type BatchFetcher struct {
+	// Workers bounds the number of accounts fetched at once.
+	Workers int
+	// CallsPerSecond limits the SOAP calls made to each host. Every job makes
+	// two: the login and the data request. Zero means no limit.
+	CallsPerSecond float64
+}
+
+// Fetch reads jobs until the channel is closed or ctx is done and streams a
+// result for every student of each job read, or a single error result if
+// the job's students cannot be listed. The returned channel is closed once
+// every started job has finished; callers must drain it.
+func (f *BatchFetcher) Fetch(ctx context.Context, jobs <-chan BatchJob) <-chan BatchResult {
+	workers := f.Workers
+	if workers <= 0 {
+		workers = defaultBatchWorkers
+	}
+	limiter := newHostLimiter(f.CallsPerSecond)
+	results := make(chan BatchResult)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				var job BatchJob
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case job, ok = <-jobs:
+					if !ok {
+						return
+					}
+				}
+				for _, result := range fetchStudents(ctx, limiter, job) {
+					results <- result
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// fetchStudents logs in with the job's credentials and fetches the data of
+// every student of the account in one call.
+func fetchStudents(ctx context.Context, limiter *hostLimiter, job BatchJob) []BatchResult {
+	failed := func(err error) []BatchResult {
+		return []BatchResult{{Job: job, Err: err}}
+	}
+	host := ""
+	if u, err := url.Parse(job.Client.client.URL()); err == nil {
+		host = u.Host
+	}
+	if err := limiter.wait(ctx, host); err != nil {
+		return failed(err)
+	}
+	session, studentIDs, err := job.Client.createUserSession(ctx, job.Credentials)
+	if err != nil {
+		return failed(err)
+	}
+	if err := limiter.wait(ctx, host); err != nil {
+		return failed(err)
+	}
+	students, err := job.Client.getStudentsData(ctx, session, studentIDs)
+
+	results := make([]BatchResult, len(studentIDs))
+	for i, id := range studentIDs {
+		results[i] = BatchResult{Job: job, StudentID: id, Err: err}
+		if err != nil {
+			continue
+		}
+		for _, student := range students {
+			if student != nil && student.StudentId == id {
+				results[i].Student = student
+				break
+			}
+		}
+		if results[i].Student == nil {
+			results[i].Err = fmt.Errorf("error: no data returned for student %d", id)
+		}
+	}
+	return results
+}
+
+type hostLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+func newHostLimiter(perSecond float64) *hostLimiter {
+	l := &hostLimiter{next: map[string]time.Time{}}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// wait blocks until host may receive another call.
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleepContext(ctx, time.Until(at))
+}
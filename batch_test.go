@@ -0,0 +1,198 @@
+package gopowerschool
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var requestedStudent = regexp.MustCompile(`<studentIDs>(\d+)</studentIDs>`)
+
+// batchServer logs accounts in with the student IDs listed for their
+// username and answers getStudentData for every requested student except
+// those in missing. It records the largest number of calls it served at once.
+func batchServer(t *testing.T, accounts map[string][]int64, missing map[string]bool, maxInFlight *int32) *PublicPortalServiceJSONPortType {
+	var inFlight int32
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		switch action := r.Header.Get("SOAPAction"); action {
+		case "urn:loginToPublicPortal":
+			for username, ids := range accounts {
+				if !strings.Contains(string(body), "<username>"+username+"</username>") {
+					continue
+				}
+				var session strings.Builder
+				session.WriteString("<userSessionVO><serviceTicket>ticket</serviceTicket>")
+				for _, id := range ids {
+					fmt.Fprintf(&session, "<studentIDs>%d</studentIDs>", id)
+				}
+				session.WriteString("</userSessionVO>")
+				io.WriteString(w, soapResponse("loginToPublicPortal", session.String()))
+				return
+			}
+			io.WriteString(w, soapResponse("loginToPublicPortal", "<messageVOs><title>Invalid login</title></messageVOs>"))
+		case "urn:getStudentData":
+			var students strings.Builder
+			for _, match := range requestedStudent.FindAllStringSubmatch(string(body), -1) {
+				if missing[match[1]] {
+					continue
+				}
+				fmt.Fprintf(&students, "<studentDataVOs><student><firstName>Student %[1]s</firstName></student><studentId>%[1]s</studentId></studentDataVOs>", match[1])
+			}
+			io.WriteString(w, soapResponse("getStudentData", students.String()))
+		default:
+			t.Errorf("unexpected call %s", action)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestBatchFetcher(t *testing.T) {
+	accounts := map[string][]int64{
+		"family": {1, 2, 3},
+		"single": {4},
+	}
+	for i := 0; i < 6; i++ {
+		accounts[fmt.Sprintf("student%d", i)] = []int64{int64(10 + i)}
+	}
+	var maxInFlight int32
+	client := batchServer(t, accounts, map[string]bool{"3": true}, &maxInFlight)
+
+	jobs := make(chan BatchJob)
+	go func() {
+		defer close(jobs)
+		for username := range accounts {
+			jobs <- BatchJob{Client: client, Credentials: StaticCredentials{Username: username, Password: "password"}}
+		}
+		jobs <- BatchJob{Client: client, Credentials: StaticCredentials{Username: "unknown", Password: "password"}}
+	}()
+
+	fetcher := &BatchFetcher{Workers: 2}
+	var fetched []int64
+	failed := map[int64]error{}
+	for result := range fetcher.Fetch(context.Background(), jobs) {
+		if result.Err != nil {
+			failed[result.StudentID] = result.Err
+			continue
+		}
+		if result.Student.StudentId != result.StudentID {
+			t.Errorf("result for student %d holds student %d", result.StudentID, result.Student.StudentId)
+		}
+		if want := fmt.Sprintf("Student %d", result.StudentID); result.Student.Student.FirstName != want {
+			t.Errorf("student %d first name = %q, want %q", result.StudentID, result.Student.Student.FirstName, want)
+		}
+		fetched = append(fetched, result.StudentID)
+	}
+
+	sort.Slice(fetched, func(i, j int) bool { return fetched[i] < fetched[j] })
+	if want := []int64{1, 2, 4, 10, 11, 12, 13, 14, 15}; fmt.Sprint(fetched) != fmt.Sprint(want) {
+		t.Errorf("fetched students %v, want %v", fetched, want)
+	}
+	if len(failed) != 2 {
+		t.Errorf("failed = %v, want the missing student and the rejected login", failed)
+	}
+	if err := failed[3]; err == nil || !strings.Contains(err.Error(), "student 3") {
+		t.Errorf("missing student error = %v", err)
+	}
+	if err := failed[0]; err == nil || !strings.Contains(err.Error(), "Invalid login") {
+		t.Errorf("rejected login error = %v", err)
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 2 {
+		t.Errorf("%d calls in flight at once with 2 workers", max)
+	} else if max < 2 {
+		t.Errorf("at most %d call in flight with 2 workers", max)
+	}
+}
+
+func TestBatchFetcherCanceled(t *testing.T) {
+	var maxInFlight int32
+	client := batchServer(t, map[string][]int64{"student": {1}}, nil, &maxInFlight)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	jobs := make(chan BatchJob)
+	results := (&BatchFetcher{}).Fetch(ctx, jobs)
+	go func() {
+		// Nobody may read the job once ctx is done, so the send is
+		// abandoned.
+		select {
+		case jobs <- BatchJob{Client: client, Credentials: StaticCredentials{Username: "student"}}:
+		case <-time.After(time.Second):
+		}
+	}()
+	for result := range results {
+		if result.Err == nil {
+			t.Errorf("fetched student %d after cancellation", result.StudentID)
+		}
+	}
+}
+
+func TestHostLimiter(t *testing.T) {
+	const interval = 50 * time.Millisecond
+	limiter := newHostLimiter(float64(time.Second / interval))
+	ctx := context.Background()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.wait(ctx, "a.example"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("three calls to one host took %v, want at least %v", elapsed, 2*interval)
+	}
+
+	start = time.Now()
+	if err := limiter.wait(ctx, "b.example"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed >= interval {
+		t.Errorf("first call to another host waited %v", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.wait(canceled, "a.example"); err == nil {
+		t.Error("wait on a canceled context returned nil")
+	}
+
+	unlimited := newHostLimiter(0)
+	start = time.Now()
+	for i := 0; i < 100; i++ {
+		if err := unlimited.wait(ctx, "a.example"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed >= interval {
+		t.Errorf("100 unlimited calls took %v", elapsed)
+	}
+}
@@ -0,0 +1,244 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Cassette is a recording of the HTTP exchanges made by a client: the digest
+// challenge and the SOAP request of every call.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded HTTP request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Status     string      `json:"status"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// WithHTTPTransport sends every request through transport instead of the
+// default one. TLS options have no effect when it is used, except with a
+// Recorder that has no Transport: it records the client's own transport.
+func WithHTTPTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientConfig) error {
+		c.transport = transport
+		return nil
+	}
+}
+
+// Recorder is an http.RoundTripper that records every exchange it forwards
+// to Transport. Passwords, service tickets, recovery tokens, cookies and
+// Authorization headers are scrubbed from the recording.
+type Recorder struct {
+	// Transport performs the requests. Nil means the transport of the client
+	// the recorder is passed to with WithHTTPTransport, TLS options included,
+	// or http.DefaultTransport when it is used on its own.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return r.record(req, transport)
+}
+
+// recording is a Recorder wrapped around a client's own transport.
+type recording struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (r recording) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.recorder.record(req, r.transport)
+}
+
+func (r *Recorder) record(req *http.Request, transport http.RoundTripper) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: scrubHeader(req.Header),
+			Body:   string(RedactEnvelope(reqBody)),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Header:     scrubHeader(res.Header),
+			Body:       string(RedactEnvelope(resBody)),
+		},
+	})
+	r.mu.Unlock()
+	return res, nil
+}
+
+// Cassette returns a copy of what has been recorded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the recording to path as JSON.
+func (r *Recorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.Cassette(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func scrubHeader(header http.Header) http.Header {
+	clean := redactHeader(header)
+	if clean != nil {
+		clean.Del("Date")
+	}
+	return clean
+}
+
+// Replayer is an http.RoundTripper that answers requests from a Cassette
+// without touching the network. Each recorded interaction is served once, to
+// the first request with the same method, URL path, SOAPAction and scrubbed
+// body.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer serving cassette.
+func NewReplayer(cassette Cassette) *Replayer {
+	return &Replayer{
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// LoadCassette reads a cassette written by Recorder.Save.
+func LoadCassette(path string) (*Replayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	return NewReplayer(cassette), nil
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	scrubbed := string(RedactEnvelope(body))
+	action := req.Header.Get("SOAPAction")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.used[i] {
+			continue
+		}
+		recorded := interaction.Request
+		if recorded.Method != req.Method || recorded.Header.Get("SOAPAction") != action || recorded.Body != scrubbed {
+			continue
+		}
+		if u, err := url.Parse(recorded.URL); err != nil || u.Path != req.URL.Path {
+			continue
+		}
+		r.used[i] = true
+		return interaction.Response.httpResponse(req), nil
+	}
+	return nil, fmt.Errorf("cassette: no recorded interaction for %s %s (SOAPAction %q)", req.Method, req.URL.Path, action)
+}
+
+// Remaining reports how many recorded interactions have not been served.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, used := range r.used {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+func (recorded RecordedResponse) httpResponse(req *http.Request) *http.Response {
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
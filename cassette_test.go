@@ -0,0 +1,161 @@
+package gopowerschool
+
+import (
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A Recorder without a Transport records through the client's own
+// transport, so the client's TLS options still apply.
+func TestRecorderUsesClientTransport(t *testing.T) {
+	server := rulesServer(t)
+	server.StartTLS()
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	recorder := &Recorder{}
+	client, err := NewClient(server.URL, WithRootCAs(pool), WithHTTPTransport(recorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := callRules(client); err != nil {
+		t.Fatalf("call through the recorder: %v", err)
+	}
+	if n := len(recorder.Cassette().Interactions); n != 2 {
+		t.Errorf("recorded %d interactions, want the challenge and the call", n)
+	}
+}
+
+// recordedLogin records a login and a rules call to a cassette file and
+// returns its path and the URL the calls were made to. The server is closed
+// before it returns, so replays cannot reach it.
+func recordedLogin(t *testing.T) (string, string) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		switch r.Header.Get("SOAPAction") {
+		case "urn:loginToPublicPortal":
+			io.WriteString(w, soapResponse("loginToPublicPortal", "<userSessionVO><serviceTicket>ticket-secret</serviceTicket><userType>2</userType></userSessionVO>"))
+		case "urn:getCredentialComplexityRules":
+			io.WriteString(w, soapResponse("getCredentialComplexityRules", "<requiredCharacterCount>8</requiredCharacterCount>"))
+		}
+	}))
+	defer server.Close()
+	recorder := &Recorder{}
+	client, err := NewClient(server.URL, WithHTTPTransport(recorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.LoginToPublicPortal(&LoginToPublicPortal{Username: "student", Password: "password-secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := callRules(client); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "cassettes", "login.json")
+	if err := recorder.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	return path, server.URL
+}
+
+func TestCassetteReplay(t *testing.T) {
+	path, url := recordedLogin(t)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"password-secret", "ticket-secret", "Digest username"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q", secret)
+		}
+	}
+
+	replayer, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewClient(url, WithHTTPTransport(replayer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	login, err := client.LoginToPublicPortal(&LoginToPublicPortal{Username: "student", Password: "password-secret"})
+	if err != nil {
+		t.Fatalf("replaying the login: %v", err)
+	}
+	if session := login.Return_.UserSessionVO; session.ServiceTicket != redacted || session.UserType != 2 {
+		t.Errorf("replayed session = %+v", session)
+	}
+	rules, err := client.GetCredentialComplexityRules(&GetCredentialComplexityRules{UserType: int32(UserTypeStudent)})
+	if err != nil {
+		t.Fatalf("replaying the rules call: %v", err)
+	}
+	if rules.Return_.RequiredCharacterCount != 8 {
+		t.Errorf("replayed rules = %+v", rules.Return_)
+	}
+	if n := replayer.Remaining(); n != 0 {
+		t.Errorf("%d recorded interactions were not replayed", n)
+	}
+
+	// Every interaction is served once.
+	if err := callRules(client); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Errorf("call after the cassette ran out = %v", err)
+	}
+}
+
+func TestCassetteReplayMismatch(t *testing.T) {
+	path, url := recordedLogin(t)
+	for _, test := range []struct {
+		name string
+		call func(*PublicPortalServiceJSONPortType) error
+	}{
+		{"body", func(client *PublicPortalServiceJSONPortType) error {
+			_, err := client.LoginToPublicPortal(&LoginToPublicPortal{Username: "teacher", Password: "password-secret"})
+			return err
+		}},
+		{"action", func(client *PublicPortalServiceJSONPortType) error {
+			_, err := client.Logout(&Logout{})
+			return err
+		}},
+		{"path", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			replayer, err := LoadCassette(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			target := url
+			call := test.call
+			if call == nil {
+				target += "/elsewhere/"
+				call = callRules
+			}
+			client, err := NewClient(target, WithHTTPTransport(replayer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := call(client); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+				t.Errorf("unrecorded call = %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadCassetteErrors(t *testing.T) {
+	if _, err := LoadCassette(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadCassette of a missing file = %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "broken.json")
+	if err := os.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCassette(path); err == nil {
+		t.Error("LoadCassette accepted a truncated file")
+	}
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type generator struct {
+	config   *Config
+	prefixes map[string]string
+	complex  map[string]*ComplexType
+	inputs   map[string]bool
+	uses     map[string]map[string]bool // element names by complex type
+	out      bytes.Buffer
+}
+
+// generate returns the formatted Go source for definitions.
+func generate(definitions *Definitions, config *Config, source string) ([]byte, error) {
+	g := &generator{
+		config:   config,
+		prefixes: definitions.namespaces(),
+		complex:  map[string]*ComplexType{},
+		inputs:   map[string]bool{},
+		uses:     map[string]map[string]bool{},
+	}
+	for i := range definitions.Schemas {
+		for j := range definitions.Schemas[i].ComplexTypes {
+			complexType := &definitions.Schemas[i].ComplexTypes[j]
+			g.complex[complexType.Name] = complexType
+		}
+	}
+	for _, schema := range definitions.Schemas {
+		for _, complexType := range schema.ComplexTypes {
+			if err := g.recordUses(complexType); err != nil {
+				return nil, err
+			}
+		}
+		for _, element := range schema.Elements {
+			if element.ComplexType != nil {
+				if err := g.recordUses(*element.ComplexType); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	operations, err := g.operations(definitions)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range operations {
+		g.inputs[op.input] = true
+	}
+
+	for _, schema := range definitions.Schemas {
+		for i := range schema.ComplexTypes {
+			complexType := &schema.ComplexTypes[i]
+			if err := g.writeStruct(complexType.Name, "", complexType, true); err != nil {
+				return nil, err
+			}
+		}
+		for _, element := range schema.Elements {
+			complexType := element.ComplexType
+			if complexType == nil {
+				name, err := qname(element.Type, g.prefixes)
+				if err != nil {
+					return nil, err
+				}
+				if complexType = g.complex[name.Local]; complexType == nil {
+					return nil, fmt.Errorf("element %s: unsupported type %s", element.Name, element.Type)
+				}
+			}
+			xmlName := schema.TargetNamespace + " " + element.Name
+			if err := g.writeStruct(element.Name, xmlName, complexType, !g.inputs[element.Name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	g.writeAliases()
+	g.writeOperations(definitions, operations)
+
+	var file bytes.Buffer
+	fmt.Fprintf(&file, "// Code generated by wsdlgen from %s. DO NOT EDIT.\n\n", filepath.ToSlash(source))
+	fmt.Fprintf(&file, "package %s\n\n", g.config.Package)
+	file.WriteString("import (\n\t\"encoding/xml\"\n")
+	if bytes.Contains(g.out.Bytes(), []byte("time.")) {
+		file.WriteString("\t\"time\"\n")
+	}
+	file.WriteString(")\n\n")
+	file.Write(g.out.Bytes())
+	return format.Source(file.Bytes())
+}
+
+// recordUses notes the element names the children of a complex type give
+// the complex types they are of.
+func (g *generator) recordUses(complexType ComplexType) error {
+	sequence := complexType.Sequence
+	if content := complexType.ComplexContent; content != nil {
+		sequence = append(append([]Element(nil), sequence...), content.Extension.Sequence...)
+	}
+	for _, element := range sequence {
+		name, err := qname(element.Type, g.prefixes)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", complexType.Name, element.Name, err)
+		}
+		if g.complex[name.Local] == nil {
+			continue
+		}
+		if g.uses[name.Local] == nil {
+			g.uses[name.Local] = map[string]bool{}
+		}
+		g.uses[name.Local][element.Name] = true
+	}
+	return nil
+}
+
+// writeStruct writes the Go type for a complex type. Elements get an
+// XMLName carrying their namespace and name. A named type used as a single
+// element gets that name without a namespace, so that it is sent in the
+// namespace of its parent rather than the one it was decoded in; a type
+// used under several names leaves its XMLName open. The config can give
+// any type a name. Named types get a MarshalXML method; see writeMarshal.
+func (g *generator) writeStruct(name, xmlName string, complexType *ComplexType, unknown bool) error {
+	typeName := exported(name)
+	if override := g.config.Types[typeName].XMLName; override != "" {
+		xmlName = override
+	}
+	uses := g.uses[name]
+	if xmlName == "" && len(uses) == 1 {
+		for use := range uses {
+			xmlName = use
+		}
+	}
+	writeComment(&g.out, "", g.doc(typeName, complexType.Documentation))
+	fmt.Fprintf(&g.out, "type %s struct {\n", typeName)
+	if xmlName != "" {
+		fmt.Fprintf(&g.out, "\tXMLName xml.Name `xml:\"%s\" json:\"-\"`\n\n", xmlName)
+	} else {
+		g.out.WriteString("\tXMLName xml.Name `json:\"-\"`\n\n")
+	}
+
+	sequence := complexType.Sequence
+	if content := complexType.ComplexContent; content != nil {
+		base, err := qname(content.Extension.Base, g.prefixes)
+		if err != nil {
+			return err
+		}
+		if g.complex[base.Local] == nil {
+			return fmt.Errorf("%s: unknown base type %s", name, content.Extension.Base)
+		}
+		fmt.Fprintf(&g.out, "\t*%s\n\n", exported(base.Local))
+		sequence = content.Extension.Sequence
+	}
+
+	for _, element := range sequence {
+		override := g.config.Fields[typeName+"."+element.Name]
+		fieldName := override.Name
+		if fieldName == "" {
+			fieldName = exported(element.Name)
+			if token.IsKeyword(element.Name) {
+				fieldName += "_"
+			}
+		}
+		fieldType := override.Type
+		if fieldType == "" {
+			var err error
+			if fieldType, err = g.goType(element); err != nil {
+				return fmt.Errorf("%s.%s: %w", typeName, element.Name, err)
+			}
+		}
+		writeComment(&g.out, "\t", element.Documentation)
+		fmt.Fprintf(&g.out, "\t%s %s `xml:\"%s,omitempty\" json:\"%s,omitempty\"`\n", fieldName, fieldType, element.Name, element.Name)
+	}
+
+	if unknown && g.config.UnknownElements != "" {
+		fmt.Fprintf(&g.out, "\n\t%s []%s `xml:\",any\" json:\"-\"`\n", g.config.UnknownElements, g.config.UnknownElementType)
+	}
+	g.out.WriteString("}\n\n")
+	if len(uses) > 0 {
+		g.writeMarshal(typeName, unknown && g.config.UnknownElements != "")
+	}
+	return nil
+}
+
+// writeMarshal writes a MarshalXML method that sends a named type under the
+// name of the field holding it, whatever name it was decoded from, and
+// leaves out the unknown elements it was decoded with so that they are not
+// echoed back to the server. Types only used as bases get none, as it
+// would be promoted to the types extending them.
+func (g *generator) writeMarshal(typeName string, unknown bool) {
+	g.out.WriteString("// MarshalXML sends v under the name of the field holding it")
+	if unknown {
+		g.out.WriteString(", without\n// the unknown elements it was decoded with")
+	}
+	g.out.WriteString(".\n")
+	fmt.Fprintf(&g.out, "func (v *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", typeName)
+	fmt.Fprintf(&g.out, "\ttype plain %s\n", typeName)
+	if unknown {
+		fmt.Fprintf(&g.out, "\tsent := plain(*v)\n\tsent.%s = nil\n\treturn e.EncodeElement(&sent, start)\n}\n\n", g.config.UnknownElements)
+	} else {
+		g.out.WriteString("\treturn e.EncodeElement((*plain)(v), start)\n}\n\n")
+	}
+}
+
+// goType returns the Go type of an element: built-in types as configured,
+// complex types by pointer, and a slice for repeated elements.
+func (g *generator) goType(element Element) (string, error) {
+	name, err := qname(element.Type, g.prefixes)
+	if err != nil {
+		return "", err
+	}
+	var goType string
+	switch {
+	case name.Space == xsdNamespace:
+		var ok bool
+		if goType, ok = g.config.XSDTypes[name.Local]; !ok {
+			return "", fmt.Errorf("no Go type configured for xs:%s", name.Local)
+		}
+	case g.complex[name.Local] != nil:
+		goType = "*" + exported(name.Local)
+	default:
+		return "", fmt.Errorf("unknown type %s", element.Type)
+	}
+	if element.MaxOccurs != "" && element.MaxOccurs != "0" && element.MaxOccurs != "1" && goType != "[]byte" {
+		goType = "[]" + goType
+	}
+	return goType, nil
+}
+
+func (g *generator) writeAliases() {
+	if len(g.config.Aliases) == 0 {
+		return
+	}
+	names := make([]string, 0, len(g.config.Aliases))
+	for name := range g.config.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	g.out.WriteString("// Aliases for types generated under other names by earlier versions.\ntype (\n")
+	for _, name := range names {
+		fmt.Fprintf(&g.out, "\t%s = %s\n", name, g.config.Aliases[name])
+	}
+	g.out.WriteString(")\n\n")
+}
+
+type operation struct {
+	portType string
+	name     string
+	action   string
+	input    string
+	output   string
+	doc      string
+}
+
+// operations lists the operations of every port type in document order,
+// with the element each message wraps and the SOAP action of the binding.
+func (g *generator) operations(definitions *Definitions) ([]operation, error) {
+	elements := map[string]string{}
+	for _, message := range definitions.Messages {
+		if len(message.Parts) != 1 {
+			return nil, fmt.Errorf("message %s: want one part, have %d", message.Name, len(message.Parts))
+		}
+		elements[message.Name] = localName(message.Parts[0].Element)
+	}
+	actions := map[string]string{}
+	for _, binding := range definitions.Bindings {
+		for _, op := range binding.Operations {
+			actions[localName(binding.Type)+"."+op.Name] = op.Operation.SOAPAction
+		}
+	}
+
+	var operations []operation
+	for _, portType := range definitions.PortTypes {
+		for _, op := range portType.Operations {
+			input, ok := elements[localName(op.Input.Message)]
+			if !ok {
+				return nil, fmt.Errorf("operation %s: unknown message %s", op.Name, op.Input.Message)
+			}
+			output, ok := elements[localName(op.Output.Message)]
+			if !ok {
+				return nil, fmt.Errorf("operation %s: unknown message %s", op.Name, op.Output.Message)
+			}
+			operations = append(operations, operation{
+				portType: portType.Name,
+				name:     op.Name,
+				action:   actions[portType.Name+"."+op.Name],
+				input:    input,
+				output:   output,
+				doc:      op.Documentation,
+			})
+		}
+	}
+	return operations, nil
+}
+
+func (g *generator) writeOperations(definitions *Definitions, operations []operation) {
+	for _, portType := range definitions.PortTypes {
+		name := exported(portType.Name)
+		writeComment(&g.out, "", g.doc(name, portType.Documentation))
+		fmt.Fprintf(&g.out, "type %s struct {\n\tclient %s\n}\n\n", name, g.config.Transport)
+		fmt.Fprintf(&g.out, "func New%s(url string, tls bool, auth %s) *%s {\n", name, g.config.AuthType, name)
+		fmt.Fprintf(&g.out, "\tclient := %s(url, tls, auth)\n\n\treturn &%s{\n\t\tclient: client,\n\t}\n}\n\n", g.config.NewClient, name)
+
+		for _, op := range operations {
+			if op.portType != portType.Name {
+				continue
+			}
+			writeComment(&g.out, "", op.doc)
+			fmt.Fprintf(&g.out, "func (service *%s) %s(request *%s) (*%s, error) {\n", name, exported(op.name), exported(op.input), exported(op.output))
+			fmt.Fprintf(&g.out, "\tresponse := new(%s)\n", exported(op.output))
+			fmt.Fprintf(&g.out, "\terr := service.client.Call(%q, request, response)\n", op.action)
+			g.out.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n\treturn response, nil\n}\n\n")
+		}
+	}
+}
+
+// doc returns the configured documentation of a Go type, falling back to
+// the WSDL's.
+func (g *generator) doc(typeName, documentation string) string {
+	if override := g.config.Types[typeName].Doc; override != "" {
+		return override
+	}
+	return documentation
+}
+
+func writeComment(out *bytes.Buffer, indent, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(out, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// exported upper-cases the first letter of name.
+func exported(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The checked-in service_gen.go must be what go generate writes.
+func TestServiceUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	var config Config
+	data, err := os.ReadFile(filepath.Join(root, "wsdlgen.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatal(err)
+	}
+	var definitions Definitions
+	if data, err = os.ReadFile(filepath.Join(root, "testdata", "PublicPortalServiceJSON.wsdl")); err != nil {
+		t.Fatal(err)
+	}
+	if err := xml.Unmarshal(data, &definitions); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := generate(&definitions, &config, "testdata/PublicPortalServiceJSON.wsdl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile(filepath.Join(root, "service_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(source, current) {
+		t.Error("service_gen.go is out of date; run go generate")
+	}
+}
+
+func TestGenerateElementNames(t *testing.T) {
+	const wsdl = `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:ns="urn:service" xmlns:vo="urn:vo">
+<types>
+<xs:schema targetNamespace="urn:vo">
+<xs:complexType name="BaseVO"><xs:sequence><xs:element name="message" type="xs:string"/></xs:sequence></xs:complexType>
+<xs:complexType name="SessionVO"><xs:complexContent><xs:extension base="vo:BaseVO"><xs:sequence><xs:element name="ticket" type="xs:string"/></xs:sequence></xs:extension></xs:complexContent></xs:complexType>
+<xs:complexType name="SchoolVO"><xs:sequence><xs:element name="name" type="xs:string"/></xs:sequence></xs:complexType>
+<xs:complexType name="DataVO"><xs:sequence>
+<xs:element name="schools" type="vo:SchoolVO" maxOccurs="unbounded"/>
+<xs:element name="remoteSchools" type="vo:SchoolVO" maxOccurs="unbounded"/>
+</xs:sequence></xs:complexType>
+</xs:schema>
+<xs:schema targetNamespace="urn:service">
+<xs:element name="getData"><xs:complexType><xs:sequence><xs:element name="session" type="vo:SessionVO"/></xs:sequence></xs:complexType></xs:element>
+</xs:schema>
+</types>
+</definitions>`
+	var definitions Definitions
+	if err := xml.Unmarshal([]byte(wsdl), &definitions); err != nil {
+		t.Fatal(err)
+	}
+	source, err := generate(&definitions, &Config{
+		Package:            "service",
+		XSDTypes:           map[string]string{"string": "string"},
+		UnknownElements:    "Extra",
+		UnknownElementType: "RawElement",
+	}, "service.wsdl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"type SessionVO struct {\n\tXMLName xml.Name `xml:\"session\" json:\"-\"`",
+		"type SchoolVO struct {\n\tXMLName xml.Name `json:\"-\"`",
+		"func (v *SchoolVO) MarshalXML(",
+		"func (v *SessionVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n\ttype plain SessionVO\n\tsent := plain(*v)\n\tsent.Extra = nil\n",
+		"type GetData struct {\n\tXMLName xml.Name `xml:\"urn:service getData\" json:\"-\"`",
+	} {
+		if !bytes.Contains(source, []byte(want)) {
+			t.Errorf("generated code lacks %q:\n%s", want, source)
+		}
+	}
+	if bytes.Contains(source, []byte("func (v *BaseVO) MarshalXML(")) {
+		t.Error("a base type got a MarshalXML method its extensions would inherit")
+	}
+}
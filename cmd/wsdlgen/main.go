@@ -0,0 +1,106 @@
+// Command wsdlgen generates the request, response and VO types of a
+// document/literal SOAP service, and a port type with one method per
+// operation, from its WSDL.
+//
+//	wsdlgen -wsdl service.wsdl -config wsdlgen.json -o service_gen.go
+//
+// The config file maps XML Schema types to Go types and overrides the names
+// and types of individual fields, so that quirks of a service survive
+// regeneration. See Config.
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// Config tunes the generated code.
+type Config struct {
+	// Package is the package clause of the generated file.
+	Package string `json:"package"`
+	// XSDTypes maps XML Schema built-in types, by local name, to Go types,
+	// e.g. "dateTime": "time.Time".
+	XSDTypes map[string]string `json:"xsdTypes"`
+	// Types overrides generated types, keyed by Go type name.
+	Types map[string]TypeConfig `json:"types"`
+	// Fields overrides individual fields, keyed by "<Type>.<element>".
+	Fields map[string]FieldConfig `json:"fields"`
+	// Aliases declares type aliases, e.g. for types that were renamed.
+	Aliases map[string]string `json:"aliases"`
+	// UnknownElements names a field added to every type except requests to
+	// keep unknown child elements. Empty adds none.
+	UnknownElements string `json:"unknownElements"`
+	// UnknownElementType is the element type of that field.
+	UnknownElementType string `json:"unknownElementType"`
+	// Transport is the interface the port type makes its calls through.
+	// NewClient is the function its constructor builds one with, given a
+	// URL, a skip-verify flag and credentials of type AuthType.
+	Transport string `json:"transport"`
+	NewClient string `json:"newClient"`
+	AuthType  string `json:"authType"`
+}
+
+// TypeConfig overrides a generated type.
+type TypeConfig struct {
+	// XMLName is the tag of the type's XMLName field, for types that must
+	// be sent as one particular element, e.g. "namespace name".
+	XMLName string `json:"xmlName"`
+	// Doc replaces the type's documentation from the WSDL as its doc
+	// comment. Port types are configured under their Go name too.
+	Doc string `json:"doc"`
+}
+
+// FieldConfig overrides the Go name or type of a field.
+type FieldConfig struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func main() {
+	wsdlPath := flag.String("wsdl", "", "WSDL `file` to read")
+	configPath := flag.String("config", "", "config `file` to read")
+	output := flag.String("o", "", "Go `file` to write; standard output if empty")
+	flag.Parse()
+	if *wsdlPath == "" || *configPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*wsdlPath, *configPath, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "wsdlgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(wsdlPath, configPath, output string) error {
+	var config Config
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("%s: %w", configPath, err)
+	}
+
+	var definitions Definitions
+	data, err = os.ReadFile(wsdlPath)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(data, &definitions); err != nil {
+		return fmt.Errorf("%s: %w", wsdlPath, err)
+	}
+
+	source, err := generate(&definitions, &config, wsdlPath)
+	if err != nil {
+		return fmt.Errorf("%s: %w", wsdlPath, err)
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+	return os.WriteFile(output, source, 0644)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// Definitions is the subset of a WSDL 1.1 document wsdlgen understands:
+// document/literal operations whose messages wrap a single element.
+type Definitions struct {
+	XMLName         xml.Name   `xml:"http://schemas.xmlsoap.org/wsdl/ definitions"`
+	TargetNamespace string     `xml:"targetNamespace,attr"`
+	Attrs           []xml.Attr `xml:",any,attr"`
+	Schemas         []Schema   `xml:"types>schema"`
+	Messages        []Message  `xml:"message"`
+	PortTypes       []PortType `xml:"portType"`
+	Bindings        []Binding  `xml:"binding"`
+}
+
+type Schema struct {
+	TargetNamespace string        `xml:"targetNamespace,attr"`
+	Attrs           []xml.Attr    `xml:",any,attr"`
+	ComplexTypes    []ComplexType `xml:"complexType"`
+	Elements        []Element     `xml:"element"`
+}
+
+type ComplexType struct {
+	Name           string          `xml:"name,attr"`
+	Documentation  string          `xml:"annotation>documentation"`
+	Sequence       []Element       `xml:"sequence>element"`
+	ComplexContent *ComplexContent `xml:"complexContent"`
+}
+
+type ComplexContent struct {
+	Extension struct {
+		Base     string    `xml:"base,attr"`
+		Sequence []Element `xml:"sequence>element"`
+	} `xml:"extension"`
+}
+
+type Element struct {
+	Name          string       `xml:"name,attr"`
+	Type          string       `xml:"type,attr"`
+	MaxOccurs     string       `xml:"maxOccurs,attr"`
+	Documentation string       `xml:"annotation>documentation"`
+	ComplexType   *ComplexType `xml:"complexType"`
+}
+
+type Message struct {
+	Name  string `xml:"name,attr"`
+	Parts []struct {
+		Name    string `xml:"name,attr"`
+		Element string `xml:"element,attr"`
+	} `xml:"part"`
+}
+
+type PortType struct {
+	Name          string `xml:"name,attr"`
+	Documentation string `xml:"documentation"`
+	Operations    []struct {
+		Name          string `xml:"name,attr"`
+		Documentation string `xml:"documentation"`
+		Input         struct {
+			Message string `xml:"message,attr"`
+		} `xml:"input"`
+		Output struct {
+			Message string `xml:"message,attr"`
+		} `xml:"output"`
+	} `xml:"operation"`
+}
+
+type Binding struct {
+	Name       string `xml:"name,attr"`
+	Type       string `xml:"type,attr"`
+	Operations []struct {
+		Name      string `xml:"name,attr"`
+		Operation struct {
+			SOAPAction string `xml:"soapAction,attr"`
+		} `xml:"operation"`
+	} `xml:"operation"`
+}
+
+// namespaces maps the prefixes declared on the definitions and schema
+// elements to their namespaces.
+func (d *Definitions) namespaces() map[string]string {
+	prefixes := map[string]string{}
+	declare := func(attrs []xml.Attr) {
+		for _, attr := range attrs {
+			if attr.Name.Space == "xmlns" {
+				prefixes[attr.Name.Local] = attr.Value
+			}
+		}
+	}
+	declare(d.Attrs)
+	for _, schema := range d.Schemas {
+		declare(schema.Attrs)
+	}
+	return prefixes
+}
+
+// qname splits a prefixed name such as "xs:string" and resolves its prefix.
+func qname(value string, prefixes map[string]string) (xml.Name, error) {
+	i := strings.Index(value, ":")
+	if i < 0 {
+		return xml.Name{Local: value}, nil
+	}
+	space, ok := prefixes[value[:i]]
+	if !ok {
+		return xml.Name{}, fmt.Errorf("undeclared namespace prefix in %q", value)
+	}
+	return xml.Name{Space: space, Local: value[i+1:]}, nil
+}
+
+// localName drops the prefix of a name such as "ns:loginRequest".
+func localName(value string) string {
+	if i := strings.Index(value, ":"); i >= 0 {
+		return value[i+1:]
+	}
+	return value
+}
@@ -0,0 +1,279 @@
+package gopowerschool
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const redacted = "[REDACTED]"
+
+// Credentials is a username and password pair. Its Password is never printed
+// by the fmt package, so it is safe to log.
+type Credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func (c Credentials) String() string {
+	return fmt.Sprintf("{Username:%s Password:%s}", c.Username, redacted)
+}
+
+func (c Credentials) GoString() string {
+	return fmt.Sprintf("gopowerschool.Credentials{Username:%q, Password:%q}", c.Username, redacted)
+}
+
+// CredentialProvider supplies the credentials used to create a session.
+type CredentialProvider interface {
+	Credentials() (Credentials, error)
+}
+
+// StaticCredentials provides a fixed username and password.
+type StaticCredentials Credentials
+
+func (c StaticCredentials) Credentials() (Credentials, error) {
+	return Credentials(c), nil
+}
+
+func (c StaticCredentials) String() string {
+	return Credentials(c).String()
+}
+
+func (c StaticCredentials) GoString() string {
+	return Credentials(c).GoString()
+}
+
+// EnvCredentials reads credentials from environment variables. Empty variable
+// names default to POWERSCHOOL_USERNAME and POWERSCHOOL_PASSWORD.
+type EnvCredentials struct {
+	UsernameVar string
+	PasswordVar string
+}
+
+func (e EnvCredentials) Credentials() (Credentials, error) {
+	userVar, passVar := e.UsernameVar, e.PasswordVar
+	if userVar == "" {
+		userVar = "POWERSCHOOL_USERNAME"
+	}
+	if passVar == "" {
+		passVar = "POWERSCHOOL_PASSWORD"
+	}
+	username, ok := os.LookupEnv(userVar)
+	if !ok || username == "" {
+		return Credentials{}, fmt.Errorf("credentials: %s is not set", userVar)
+	}
+	password, ok := os.LookupEnv(passVar)
+	if !ok || password == "" {
+		return Credentials{}, fmt.Errorf("credentials: %s is not set", passVar)
+	}
+	return Credentials{Username: username, Password: password}, nil
+}
+
+// EncryptedFileCredentials reads credentials from a file written by
+// SaveEncryptedCredentials. The file is sealed with AES-256-GCM under a key
+// derived from Passphrase with PBKDF2-HMAC-SHA256.
+type EncryptedFileCredentials struct {
+	Path       string
+	Passphrase string
+}
+
+func (f EncryptedFileCredentials) Credentials() (Credentials, error) {
+	var creds Credentials
+	if err := openSealedFile(f.Path, f.Passphrase, &creds); err != nil {
+		return Credentials{}, err
+	}
+	return creds, nil
+}
+
+func (f EncryptedFileCredentials) String() string {
+	return fmt.Sprintf("{Path:%s Passphrase:%s}", f.Path, redacted)
+}
+
+// SaveEncryptedCredentials writes creds to path for EncryptedFileCredentials.
+func SaveEncryptedCredentials(path, passphrase string, creds Credentials) error {
+	return writeSealedFile(path, passphrase, creds)
+}
+
+// KeyringFile is a portable stand-in for an OS keyring: an encrypted file
+// holding credentials for several accounts. It provides the credentials
+// stored under Account.
+type KeyringFile struct {
+	Path       string
+	Passphrase string
+	Account    string
+}
+
+// DefaultKeyringPath returns the keyring location under the user's config
+// directory.
+func DefaultKeyringPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gopowerschool", "keyring"), nil
+}
+
+func (k KeyringFile) Credentials() (Credentials, error) {
+	entries, err := k.load()
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds, ok := entries[k.Account]
+	if !ok {
+		return Credentials{}, fmt.Errorf("credentials: no keyring entry for %q", k.Account)
+	}
+	return creds, nil
+}
+
+// Set stores creds under the keyring's Account, creating the file if needed.
+func (k KeyringFile) Set(creds Credentials) error {
+	entries, err := k.load()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if entries == nil {
+		entries = map[string]Credentials{}
+	}
+	entries[k.Account] = creds
+	return writeSealedFile(k.Path, k.Passphrase, entries)
+}
+
+// Delete removes the keyring's Account entry.
+func (k KeyringFile) Delete() error {
+	entries, err := k.load()
+	if err != nil {
+		return err
+	}
+	delete(entries, k.Account)
+	return writeSealedFile(k.Path, k.Passphrase, entries)
+}
+
+func (k KeyringFile) String() string {
+	return fmt.Sprintf("{Path:%s Account:%s Passphrase:%s}", k.Path, k.Account, redacted)
+}
+
+func (k KeyringFile) load() (map[string]Credentials, error) {
+	var entries map[string]Credentials
+	if err := openSealedFile(k.Path, k.Passphrase, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+const (
+	sealedSaltSize   = 16
+	sealedVersion    = 1
+	sealedIterations = 600000
+)
+
+var errBadPassphrase = errors.New("credentials: wrong passphrase or corrupted file")
+
+type sealedFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func sealingKey(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, errors.New("credentials: empty passphrase")
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, sealedIterations, 32)
+	if err != nil {
+		return nil, fmt.Errorf("credentials: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func writeSealedFile(path, passphrase string, v interface{}) error {
+	plaintext, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	salt := make([]byte, sealedSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+	aead, err := sealingKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	data, err := json.Marshal(sealedFile{
+		Version:    sealedVersion,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func openSealedFile(path, passphrase string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var sealed sealedFile
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		return fmt.Errorf("credentials: %s: %v", path, err)
+	}
+	if sealed.Version != sealedVersion {
+		return fmt.Errorf("credentials: %s: unsupported version %d", path, sealed.Version)
+	}
+	aead, err := sealingKey(passphrase, sealed.Salt)
+	if err != nil {
+		return err
+	}
+	if len(sealed.Nonce) != aead.NonceSize() {
+		return errBadPassphrase
+	}
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, nil)
+	if err != nil {
+		return errBadPassphrase
+	}
+	return json.Unmarshal(plaintext, v)
+}
+
+// The request types below carry secrets; keep them out of formatted output.
+
+func (l LoginToPublicPortal) String() string {
+	return fmt.Sprintf("{Username:%s Password:%s}", l.Username, redacted)
+}
+
+func (l Login) String() string {
+	return fmt.Sprintf("{Username:%s Password:%s UserType:%d}", l.Username, redacted, l.UserType)
+}
+
+func (r RecoverPassword) String() string {
+	return fmt.Sprintf("{UserType:%d UserName:%s RecoveryToken:%s NewPassword:%s}", r.UserType, r.UserName, redacted, redacted)
+}
+
+func (u UserSessionVO) String() string {
+	return fmt.Sprintf("{UserId:%d UserType:%d ServiceTicket:%s ServerCurrentTime:%s}", u.UserId, u.UserType, redacted, u.ServerCurrentTime)
+}
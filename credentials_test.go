@@ -0,0 +1,40 @@
+package gopowerschool
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryptedFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds")
+	want := Credentials{Username: "student", Password: "hunter2"}
+	if err := SaveEncryptedCredentials(path, "passphrase", want); err != nil {
+		t.Fatal(err)
+	}
+
+	var sealed sealedFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		t.Fatal(err)
+	}
+	if sealed.Version != sealedVersion {
+		t.Errorf("file has version %d, want %d", sealed.Version, sealedVersion)
+	}
+
+	got, err := EncryptedFileCredentials{Path: path, Passphrase: "passphrase"}.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Credentials() = %v, want %v", got, want)
+	}
+	if _, err := (EncryptedFileCredentials{Path: path, Passphrase: "wrong"}).Credentials(); !errors.Is(err, errBadPassphrase) {
+		t.Errorf("Credentials() with the wrong passphrase = %v, want %v", err, errBadPassphrase)
+	}
+}
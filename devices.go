@@ -0,0 +1,191 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DeviceToken is a push notification token linked to one of your users.
+type DeviceToken struct {
+	UserID   string
+	Token    string
+	LinkedAt time.Time
+}
+
+// DeviceStore records which device tokens are linked to which users.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type DeviceStore interface {
+	// Tokens returns the tokens linked to a user.
+	Tokens(userID string) ([]DeviceToken, error)
+	// All returns every linked token.
+	All() ([]DeviceToken, error)
+	// Save records a token, replacing any record of the same user and token.
+	Save(token DeviceToken) error
+	// Delete forgets a token. Deleting an unknown token is not an error.
+	Delete(userID, token string) error
+}
+
+// MemoryDeviceStore is a DeviceStore kept in memory, for tests and single
+// process deployments. The zero value is ready to use.
+type MemoryDeviceStore struct {
+	mu     sync.Mutex
+	tokens map[string]map[string]DeviceToken
+}
+
+func (s *MemoryDeviceStore) Tokens(userID string) ([]DeviceToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedTokens(s.tokens[userID]), nil
+}
+
+func (s *MemoryDeviceStore) All() ([]DeviceToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var all []DeviceToken
+	for _, tokens := range s.tokens {
+		all = append(all, sortedTokens(tokens)...)
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].UserID < all[j].UserID })
+	return all, nil
+}
+
+func (s *MemoryDeviceStore) Save(token DeviceToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens == nil {
+		s.tokens = map[string]map[string]DeviceToken{}
+	}
+	if s.tokens[token.UserID] == nil {
+		s.tokens[token.UserID] = map[string]DeviceToken{}
+	}
+	s.tokens[token.UserID][token.Token] = token
+	return nil
+}
+
+func (s *MemoryDeviceStore) Delete(userID, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens[userID], token)
+	if len(s.tokens[userID]) == 0 {
+		delete(s.tokens, userID)
+	}
+	return nil
+}
+
+func sortedTokens(tokens map[string]DeviceToken) []DeviceToken {
+	var sorted []DeviceToken
+	for _, token := range tokens {
+		sorted = append(sorted, token)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LinkedAt.Before(sorted[j].LinkedAt) })
+	return sorted
+}
+
+// DeviceRegistry links your users' device tokens to their PowerSchool
+// accounts, so the server sends them push notifications, and records the
+// links in a DeviceStore.
+type DeviceRegistry struct {
+	Client *PublicPortalServiceJSONPortType
+	Store  DeviceStore
+	// Session returns a new session for a user. The service only delinks a
+	// token as part of a logout, so tokens delinked outside of Logout, on
+	// rotation and when reconciling, log out a session obtained from
+	// Session. If it is nil, those tokens are only forgotten by the store.
+	Session func(userID string) (*UserSessionVO, error)
+}
+
+// NewDeviceRegistry returns a registry without a Session function.
+func NewDeviceRegistry(client *PublicPortalServiceJSONPortType, store DeviceStore) *DeviceRegistry {
+	return &DeviceRegistry{Client: client, Store: store}
+}
+
+// Tokens returns the tokens linked to a user.
+func (r *DeviceRegistry) Tokens(userID string) ([]DeviceToken, error) {
+	return r.Store.Tokens(userID)
+}
+
+// Login links a device token to the account of session, which the user
+// has just logged in to. The token is only stored once the server has
+// accepted it.
+func (r *DeviceRegistry) Login(userID string, session *UserSessionVO, token string) error {
+	response, err := r.Client.LinkDeviceTokenToUser(&LinkDeviceTokenToUser{UserSessionVO: session, DeviceToken: token})
+	if err != nil {
+		return err
+	}
+	if message := response.Return_; message != nil && (message.Title != "" || message.Description != "" || message.MsgCode != 0) {
+		return fmt.Errorf("error: %s - %s", message.Title, message.Description)
+	}
+	return r.Store.Save(DeviceToken{UserID: userID, Token: token, LinkedAt: time.Now()})
+}
+
+// Logout ends session and delinks the device token from its account. The
+// token stays in the store if the server cannot be reached, so that a
+// later Reconcile can delink it.
+func (r *DeviceRegistry) Logout(userID string, session *UserSessionVO, token string) error {
+	if err := r.delink(session, token); err != nil {
+		return err
+	}
+	return r.Store.Delete(userID, token)
+}
+
+// Rotate replaces a device token the push service has rotated, linking
+// newToken to the account of session and delinking oldToken.
+func (r *DeviceRegistry) Rotate(userID string, session *UserSessionVO, oldToken, newToken string) error {
+	if oldToken == newToken {
+		return nil
+	}
+	if err := r.Login(userID, session, newToken); err != nil {
+		return err
+	}
+	return r.forget(userID, oldToken)
+}
+
+// Reconcile delinks and forgets every stored token for which stale returns
+// true, such as tokens the push service reports as unregistered or tokens
+// linked too long ago. It carries on past failures and returns them
+// joined; failed tokens stay in the store for the next run.
+func (r *DeviceRegistry) Reconcile(stale func(DeviceToken) bool) error {
+	tokens, err := r.Store.All()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, token := range tokens {
+		if !stale(token) {
+			continue
+		}
+		if err := r.forget(token.UserID, token.Token); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", token.UserID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// forget delinks a token using a session of its own, if the registry can
+// get one, and deletes it from the store.
+func (r *DeviceRegistry) forget(userID, token string) error {
+	if r.Session != nil {
+		session, err := r.Session(userID)
+		if err != nil {
+			return err
+		}
+		if err := r.delink(session, token); err != nil {
+			return err
+		}
+	}
+	return r.Store.Delete(userID, token)
+}
+
+func (r *DeviceRegistry) delink(session *UserSessionVO, token string) error {
+	response, err := r.Client.LogoutAndDelinkDeviceToken(&LogoutAndDelinkDeviceToken{UserSessionVO: session, DeviceToken: token})
+	if err != nil {
+		return err
+	}
+	if response.Return_ != nil && len(response.Return_.MessageVOs) > 0 {
+		return fmt.Errorf("error: %s - %s", response.Return_.MessageVOs[0].Title, response.Return_.MessageVOs[0].Description)
+	}
+	return nil
+}
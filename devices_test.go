@@ -0,0 +1,35 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeviceRegistryLogin(t *testing.T) {
+	reply := ""
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		io.WriteString(w, soapResponse("linkDeviceTokenToUser", reply))
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &MemoryDeviceStore{}
+	registry := NewDeviceRegistry(client, store)
+
+	reply = "<msgCode>12</msgCode><title>Invalid session</title><description>Please log in again.</description>"
+	if err := registry.Login("ada", &UserSessionVO{}, "rejected"); err == nil {
+		t.Error("Login succeeded although the server rejected the token")
+	}
+	reply = ""
+	if err := registry.Login("ada", &UserSessionVO{}, "accepted"); err != nil {
+		t.Fatal(err)
+	}
+	tokens, _ := store.Tokens("ada")
+	if len(tokens) != 1 || tokens[0].Token != "accepted" {
+		t.Errorf("stored %+v, want only the accepted token", tokens)
+	}
+}
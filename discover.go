@@ -0,0 +1,79 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Endpoint describes a PowerSchool server as seen before anyone logs in.
+type Endpoint struct {
+	URL string
+	// ServerInfo carries the server's API version, time zone and SSO
+	// endpoints, or is nil if the server did not describe itself.
+	ServerInfo      *ServerInfo
+	Location        *time.Location
+	CredentialRules *CredentialComplexityRulesVO
+}
+
+// Discover confirms that the client's URL serves PublicPortalServiceJSON and
+// reports the server's API version and time zone. Neither call it makes
+// names an account, so nothing reaches the server's login audit or lockout
+// counters: the endpoint is probed with GetCredentialComplexityRules, and
+// server information comes from a Logout without a session, which the
+// server answers with its ServerInfo.
+func (client *PublicPortalServiceJSONPortType) Discover() (*Endpoint, error) {
+	endpoint := &Endpoint{URL: client.client.URL()}
+
+	rules, err := client.GetCredentialComplexityRules(&GetCredentialComplexityRules{UserType: int32(UserTypeStudent)})
+	if err != nil {
+		return nil, fmt.Errorf("no PublicPortalServiceJSON endpoint at %s: %w", endpoint.URL, err)
+	}
+	if rules.Return_ == nil {
+		return nil, fmt.Errorf("no PublicPortalServiceJSON endpoint at %s: empty response", endpoint.URL)
+	}
+	endpoint.CredentialRules = rules.Return_
+
+	response, err := client.Logout(&Logout{})
+	var fault *SOAPFault
+	if errors.As(err, &fault) {
+		return endpoint, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.Return_ != nil && response.Return_.UserSessionVO != nil && response.Return_.UserSessionVO.ServerInfo != nil {
+		endpoint.ServerInfo = response.Return_.UserSessionVO.ServerInfo
+		endpoint.Location = endpoint.ServerInfo.Location()
+	}
+	return endpoint, nil
+}
+
+// Location returns the server's time zone. It falls back to a fixed zone built
+// from RawOffset when TimeZoneName is not a known IANA name.
+func (info *ServerInfo) Location() *time.Location {
+	if info.TimeZoneName != "" {
+		if loc, err := time.LoadLocation(info.TimeZoneName); err == nil {
+			return loc
+		}
+	}
+	name := info.TimeZoneName
+	if name == "" {
+		name = "UTC"
+	}
+	return time.FixedZone(name, int(info.RawOffset/1000))
+}
+
+// SAMLEndpoint returns the path of the server's SAML single sign-on
+// endpoint for userType accounts, or "" if it has none.
+func (info *ServerInfo) SAMLEndpoint(userType UserType) string {
+	switch userType {
+	case UserTypeGuardian:
+		return info.ParentSAMLEndPoint
+	case UserTypeStudent:
+		return info.StudentSAMLEndPoint
+	case UserTypeTeacher:
+		return info.TeacherSAMLEndPoint
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	logout := soapResponse("logout", "<userSessionVO><serverInfo><apiVersion>23.4.0</apiVersion><timeZoneName>America/Chicago</timeZoneName></serverInfo></userSessionVO>")
+	var actions []string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		actions = append(actions, r.Header.Get("SOAPAction"))
+		switch r.Header.Get("SOAPAction") {
+		case "urn:getCredentialComplexityRules":
+			io.WriteString(w, soapResponse("getCredentialComplexityRules", "<requiredCharacterCount>8</requiredCharacterCount>"))
+		case "urn:logout":
+			io.WriteString(w, logout)
+		default:
+			t.Errorf("unexpected call %s", r.Header.Get("SOAPAction"))
+		}
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, err := client.Discover()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint.CredentialRules.RequiredCharacterCount != 8 {
+		t.Errorf("rules = %+v", endpoint.CredentialRules)
+	}
+	if endpoint.ServerInfo == nil || endpoint.ServerInfo.ApiVersion != "23.4.0" {
+		t.Fatalf("server info = %+v", endpoint.ServerInfo)
+	}
+	if endpoint.Location.String() != "America/Chicago" {
+		t.Errorf("location = %v", endpoint.Location)
+	}
+
+	// A server that faults on the session-less logout is still discovered.
+	logout = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><soapenv:Fault>` +
+		`<faultcode>soapenv:Server</faultcode><faultstring>no session</faultstring></soapenv:Fault></soapenv:Body></soapenv:Envelope>`
+	if endpoint, err = client.Discover(); err != nil {
+		t.Fatal(err)
+	}
+	if endpoint.ServerInfo != nil || endpoint.CredentialRules == nil {
+		t.Errorf("endpoint = %+v", endpoint)
+	}
+}
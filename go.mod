@@ -0,0 +1,3 @@
+module github.com/reteps/gopowerschool
+
+go 1.24
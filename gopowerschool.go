@@ -1,44 +1,94 @@
 package gopowerschool
 
 import (
+	"context"
 	"fmt"
 )
 
+// Client returns a client with the mobile app's defaults and TLS verification
+// disabled. Use NewClient to configure it. If url is invalid, every call on the
+// returned client fails with the validation error.
 func Client(url string) *PublicPortalServiceJSONPortType {
-	auth := DigestAuth{Login: "pearson", Password: "m0bApP5"}
-	if url[len(url)-1] != '/' {
-		url += "/"
+	client, err := NewClient(url, WithInsecureSkipVerify())
+	if err != nil {
+		return NewServiceWithTransport(&SOAPClient{err: err})
 	}
-	wsdl_url := fmt.Sprintf("%s/pearson-rest/services/PublicPortalServiceJSON?wsdl", url)
-	return NewPublicPortalServiceJSONPortType(wsdl_url, true, &auth)
+	return client
 }
 func (client *PublicPortalServiceJSONPortType) CreateUserSessionAndStudent(username, password string) (*UserSessionVO, int64, error) {
-
-	PublicPortalLogin := LoginToPublicPortal{Username: username, Password: password}
-	response, err := client.LoginToPublicPortal(&PublicPortalLogin)
+	return client.CreateUserSessionAndStudentFrom(StaticCredentials{Username: username, Password: password})
+}
+func (client *PublicPortalServiceJSONPortType) CreateUserSessionAndStudentFrom(provider CredentialProvider) (*UserSessionVO, int64, error) {
+	return client.createUserSessionAndStudent(context.Background(), provider)
+}
+func (client *PublicPortalServiceJSONPortType) createUserSessionAndStudent(ctx context.Context, provider CredentialProvider) (*UserSessionVO, int64, error) {
+	session, studentIDs, err := client.createUserSession(ctx, provider)
 	if err != nil {
 		return nil, 0, err
 	}
+	return session, studentIDs[0], nil
+}
+func (client *PublicPortalServiceJSONPortType) createUserSession(ctx context.Context, provider CredentialProvider) (*UserSessionVO, []int64, error) {
+	creds, err := provider.Credentials()
+	if err != nil {
+		return nil, nil, err
+	}
+	PublicPortalLogin := LoginToPublicPortal{Username: creds.Username, Password: creds.Password}
+	response := new(LoginToPublicPortalResponse)
+	if err := client.client.CallContext(ctx, "urn:loginToPublicPortal", &PublicPortalLogin, response); err != nil {
+		return nil, nil, err
+	}
+	if response.Return_ == nil {
+		return nil, nil, fmt.Errorf("error: no session returned")
+	}
 	if response.Return_.MessageVOs != nil {
-		return nil, 0, fmt.Errorf("error: %s - %s", response.Return_.MessageVOs[0].Title, response.Return_.MessageVOs[0].Description)
+		return nil, nil, fmt.Errorf("error: %s - %s", response.Return_.MessageVOs[0].Title, response.Return_.MessageVOs[0].Description)
+	}
+	if response.Return_.UserSessionVO == nil || len(response.Return_.UserSessionVO.StudentIDs) == 0 {
+		return nil, nil, fmt.Errorf("error: no students linked to this account")
 	}
 	newSession := UserSessionVO{
 		UserId:            response.Return_.UserSessionVO.UserId,
 		ServiceTicket:     response.Return_.UserSessionVO.ServiceTicket,
-		ServerInfo:        &ServerInfo{ApiVersion: response.Return_.UserSessionVO.ServerInfo.ApiVersion},
 		ServerCurrentTime: response.Return_.UserSessionVO.ServerCurrentTime,
 		UserType:          response.Return_.UserSessionVO.UserType}
-	return &newSession, int64(response.Return_.UserSessionVO.StudentIDs[0]), nil
+	if info := response.Return_.UserSessionVO.ServerInfo; info != nil {
+		newSession.ServerInfo = &ServerInfo{ApiVersion: info.ApiVersion}
+	}
+	studentIDs := make([]int64, len(response.Return_.UserSessionVO.StudentIDs))
+	for i, id := range response.Return_.UserSessionVO.StudentIDs {
+		studentIDs[i] = int64(id)
+	}
+	return &newSession, studentIDs, nil
 }
 func (client *PublicPortalServiceJSONPortType) GetStudent(username, password string) (*StudentDataVO, error) {
-	session, userID, err := client.CreateUserSessionAndStudent(username, password)
+	return client.GetStudentFrom(StaticCredentials{Username: username, Password: password})
+}
+func (client *PublicPortalServiceJSONPortType) GetStudentFrom(provider CredentialProvider) (*StudentDataVO, error) {
+	session, userID, err := client.CreateUserSessionAndStudentFrom(provider)
 	if err != nil {
 		return nil, err
 	}
-	studentDataArguments := GetStudentData{UserSessionVO: session, StudentIDs: []int64{userID}, Qil: &QueryIncludeListVO{Includes: []int32{1}}}
-	student, err := client.GetStudentData(&studentDataArguments)
+	return client.getStudentData(context.Background(), session, userID)
+}
+func (client *PublicPortalServiceJSONPortType) getStudentData(ctx context.Context, session *UserSessionVO, userID int64) (*StudentDataVO, error) {
+	students, err := client.getStudentsData(ctx, session, []int64{userID})
 	if err != nil {
 		return nil, err
 	}
-	return student.Return_.StudentDataVOs[0], nil
+	if len(students) == 0 {
+		return nil, fmt.Errorf("error: no data returned for student %d", userID)
+	}
+	return students[0], nil
+}
+func (client *PublicPortalServiceJSONPortType) getStudentsData(ctx context.Context, session *UserSessionVO, studentIDs []int64) ([]*StudentDataVO, error) {
+	studentDataArguments := GetStudentData{UserSessionVO: session, StudentIDs: studentIDs, Qil: &QueryIncludeListVO{Includes: []int32{1}}}
+	student := new(GetStudentDataResponse)
+	if err := client.client.CallContext(ctx, "urn:getStudentData", &studentDataArguments, student); err != nil {
+		return nil, err
+	}
+	if student.Return_ == nil {
+		return nil, nil
+	}
+	return student.Return_.StudentDataVOs, nil
 }
@@ -0,0 +1,62 @@
+package gopowerschool
+
+import (
+	"context"
+	"net/http"
+)
+
+// SOAPCall describes one SOAPClient call as it passes through interceptors.
+type SOAPCall struct {
+	Context  context.Context
+	Action   string
+	Request  interface{}
+	Response interface{}
+	// Header is added to every HTTP request carrying the SOAP envelope.
+	Header http.Header
+	// Envelope is the encoded request envelope.
+	Envelope []byte
+
+	// Handlers are the StreamHandlers given to CallStream, if any.
+	Handlers StreamHandlers
+	// CaptureResponse keeps a copy of the response envelope in
+	// ResponseEnvelope. Responses are otherwise decoded straight from the
+	// network without being buffered.
+	CaptureResponse bool
+
+	// HTTPResponse, ResponseSize and ResponseEnvelope describe the last SOAP
+	// exchange once next returns. The response body is already closed. They
+	// are unset when no exchange completed or an interceptor answered the
+	// call itself.
+	HTTPResponse     *http.Response
+	ResponseSize     int64
+	ResponseEnvelope []byte
+	// Attempts counts the exchanges made, including retries.
+	Attempts int
+}
+
+// Invoker performs a call.
+type Invoker func(call *SOAPCall) error
+
+// Interceptor wraps every SOAPClient call. It may change the call before
+// passing it to next, inspect the result afterwards, or fill call.Response and
+// return without calling next at all.
+type Interceptor func(call *SOAPCall, next Invoker) error
+
+// WithInterceptors adds interceptors to the client. The first one given is the
+// outermost. Retries happen inside the innermost interceptor.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *clientConfig) error {
+		c.interceptors = append(c.interceptors, interceptors...)
+		return nil
+	}
+}
+
+func chainInterceptors(interceptors []Interceptor, final Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], final
+		final = func(call *SOAPCall) error {
+			return interceptor(call, next)
+		}
+	}
+	return final
+}
@@ -0,0 +1,159 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// tracing returns an interceptor that logs name around the rest of the call.
+func tracing(log *[]string, name string) Interceptor {
+	return func(call *SOAPCall, next Invoker) error {
+		*log = append(*log, name+">")
+		err := next(call)
+		*log = append(*log, "<"+name)
+		return err
+	}
+}
+
+func TestInterceptorOrder(t *testing.T) {
+	var log []string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		log = append(log, "server "+r.Header.Get("X-Trace"))
+		io.WriteString(w, soapResponse("getCredentialComplexityRules", ""))
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL,
+		WithInterceptors(tracing(&log, "a"), tracing(&log, "b")),
+		WithInterceptors(tracing(&log, "c"), func(call *SOAPCall, next Invoker) error {
+			call.Header.Set("X-Trace", strings.Join(log, " "))
+			return next(call)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := callRules(client); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(log, " "), "a> b> c> server a> b> c> <c <b <a"; got != want {
+		t.Errorf("calls ran as %q, want %q", got, want)
+	}
+}
+
+// An interceptor may answer a call itself; the interceptors inside it and
+// the server are then never reached.
+func TestInterceptorShortCircuit(t *testing.T) {
+	var log []string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		t.Error("a short-circuited call reached the server")
+	}))
+	defer server.Close()
+	cached := func(call *SOAPCall, next Invoker) error {
+		call.Response.(*GetCredentialComplexityRulesResponse).Return_ = &CredentialComplexityRulesVO{RequiredCharacterCount: 12}
+		return nil
+	}
+	client, err := NewClient(server.URL, WithInterceptors(tracing(&log, "outer"), cached, tracing(&log, "inner")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := client.GetCredentialComplexityRules(&GetCredentialComplexityRules{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Return_.RequiredCharacterCount != 12 {
+		t.Errorf("response = %+v, want the interceptor's", response.Return_)
+	}
+	if got := strings.Join(log, " "); got != "outer> <outer" {
+		t.Errorf("calls ran as %q", got)
+	}
+}
+
+func TestInterceptorErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, faultResponse)
+	}))
+	defer server.Close()
+
+	// The server's error reaches every interceptor on its way out, and an
+	// interceptor may wrap it.
+	var seen []error
+	observe := func(call *SOAPCall, next Invoker) error {
+		err := next(call)
+		seen = append(seen, err)
+		return err
+	}
+	wrap := func(call *SOAPCall, next Invoker) error {
+		if err := next(call); err != nil {
+			return fmt.Errorf("rules: %w", err)
+		}
+		return nil
+	}
+	client, err := NewClient(server.URL, WithInterceptors(observe, wrap, observe))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = callRules(client)
+	var fault *SOAPFault
+	if !errors.As(err, &fault) || !strings.HasPrefix(err.Error(), "rules: ") {
+		t.Errorf("call error = %v, want the wrapped fault", err)
+	}
+	if len(seen) != 2 || !errors.As(seen[1], &fault) || seen[0] == seen[1] {
+		t.Errorf("interceptors saw %v", seen)
+	}
+
+	// An interceptor's own error stops the call before it is sent.
+	denied := errors.New("denied")
+	calls = 0
+	client, err = NewClient(server.URL, WithInterceptors(observe, func(call *SOAPCall, next Invoker) error {
+		return denied
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := callRules(client); !errors.Is(err, denied) {
+		t.Errorf("call error = %v, want %v", err, denied)
+	}
+	if calls != 0 {
+		t.Errorf("a denied call was sent %d times", calls)
+	}
+}
+
+// Retries happen inside the innermost interceptor, which sees one call.
+func TestInterceptorSeesRetriesOnce(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, soapResponse("getCredentialComplexityRules", ""))
+	}))
+	defer server.Close()
+	var log []string
+	var recorded int
+	client, err := NewClient(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 2}), WithInterceptors(tracing(&log, "a"),
+		func(call *SOAPCall, next Invoker) error {
+			err := next(call)
+			recorded = call.Attempts
+			return err
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := callRules(client); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(log, " "); got != "a> <a" || recorded != 2 {
+		t.Errorf("interceptor ran as %q and saw %d attempts, want once and 2", got, recorded)
+	}
+}
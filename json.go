@@ -0,0 +1,89 @@
+package gopowerschool
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// JSONClient calls the service with its JSON encoding instead of SOAP. It
+// shares SOAPClient's digest authentication, interceptors and retries.
+type JSONClient struct {
+	*SOAPClient
+}
+
+func NewJSONClient(url string, insecure bool, auth *DigestAuth) *JSONClient {
+	client := NewSOAPClient(url, insecure, auth)
+	client.codec = jsonCodec{}
+	return &JSONClient{client}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) setHeaders(header http.Header, soapAction string) {
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	if soapAction != "" {
+		header.Set("SOAPAction", soapAction)
+	}
+}
+
+func (jsonCodec) encode(ctx context.Context, soapAction string, request interface{}) ([]byte, error) {
+	name, err := elementName(request)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]interface{}{name: request})
+}
+
+func (jsonCodec) decode(r io.Reader, call *SOAPCall) (*SOAPFault, error) {
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&body); err != nil {
+		return nil, err
+	}
+	if raw, ok := body["Fault"]; ok {
+		fault := new(SOAPFault)
+		if err := json.Unmarshal(raw, fault); err != nil {
+			return nil, err
+		}
+		return fault, nil
+	}
+	name, err := elementName(call.Response)
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := body[name]
+	if !ok {
+		return nil, fmt.Errorf("json response has no %q member", name)
+	}
+	return nil, json.Unmarshal(raw, call.Response)
+}
+
+// elementName returns the local XML element name of a request or response
+// struct, taken from its XMLName tag.
+func elementName(v interface{}) (string, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", errors.New("json: request and response must be structs")
+	}
+	field, ok := t.FieldByName("XMLName")
+	if !ok || field.Type != reflect.TypeOf(xml.Name{}) {
+		return "", fmt.Errorf("json: %s has no XMLName", t.Name())
+	}
+	name := strings.Split(field.Tag.Get("xml"), ",")[0]
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" {
+		return "", fmt.Errorf("json: %s has no element name", t.Name())
+	}
+	return name, nil
+}
@@ -0,0 +1,169 @@
+package gopowerschool
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// RawElement is an element kept verbatim, either because the type it
+// appeared in does not model it or because the service leaves its content
+// open, as with StudentDataVO.Extension. When it comes from the JSON
+// encoding, JSON holds the value instead.
+type RawElement struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr      `xml:",any,attr"`
+	Inner   string          `xml:",innerxml"`
+	JSON    json.RawMessage `xml:"-"`
+}
+
+// Decode unmarshals the element into v.
+func (e RawElement) Decode(v interface{}) error {
+	if e.JSON != nil {
+		return json.Unmarshal(e.JSON, v)
+	}
+	return xml.Unmarshal([]byte(e.String()), v)
+}
+
+func (e RawElement) MarshalJSON() ([]byte, error) {
+	if e.JSON != nil {
+		return e.JSON, nil
+	}
+	return json.Marshal(e.Inner)
+}
+
+func (e *RawElement) UnmarshalJSON(data []byte) error {
+	e.JSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// String returns the element as XML.
+func (e RawElement) String() string {
+	var b strings.Builder
+	b.WriteString("<" + e.XMLName.Local)
+	for _, attr := range e.Attrs {
+		b.WriteString(" " + attr.Name.Local + `="`)
+		xml.EscapeText(&b, []byte(attr.Value))
+		b.WriteByte('"')
+	}
+	b.WriteString(">" + e.Inner + "</" + e.XMLName.Local + ">")
+	return b.String()
+}
+
+// SchemaDrift is an element in a response that the client's types do not
+// model, typically a field added in a newer ApiVersion.
+type SchemaDrift struct {
+	Action string
+	// Path locates the value holding the element, using element names and
+	// slice indexes, e.g. "return.studentDataVOs[0].student". It is "Body"
+	// for extra elements of the SOAP body itself.
+	Path    string
+	Element RawElement
+}
+
+func (d SchemaDrift) String() string {
+	return fmt.Sprintf("%s: unknown element <%s> in %s", d.Action, d.Element.XMLName.Local, d.Path)
+}
+
+// WithLenientDecoding makes the client accept SOAP bodies holding elements
+// besides the expected response, and report every element the response
+// types do not model. Unknown elements are always kept in the Extra field of
+// the value they appeared in, but are not sent back when that value is
+// passed in a request; in lenient mode each is also passed to warn.
+// If warn is nil, drift is logged at Warn level to the WithLogger logger.
+func WithLenientDecoding(warn func(SchemaDrift)) ClientOption {
+	return func(c *clientConfig) error {
+		c.lenient = true
+		c.driftHandler = warn
+		return nil
+	}
+}
+
+// expects reports whether name is the element b.Content decodes or a fault.
+func (b *SOAPBody) expects(name xml.Name) bool {
+	if isFault(name) {
+		return true
+	}
+	if b.Content == nil {
+		return false
+	}
+	local, err := elementName(b.Content)
+	if err != nil {
+		return true
+	}
+	return name.Local == local
+}
+
+// schemaDrift lists the Extra elements found anywhere in response, followed
+// by those of the SOAP body.
+func schemaDrift(action string, response interface{}, body []RawElement) []SchemaDrift {
+	var drift []SchemaDrift
+	collectDrift(reflect.ValueOf(response), "", func(path string, element RawElement) {
+		drift = append(drift, SchemaDrift{Action: action, Path: path, Element: element})
+	})
+	for _, element := range body {
+		drift = append(drift, SchemaDrift{Action: action, Path: "Body", Element: element})
+	}
+	return drift
+}
+
+var rawElementsType = reflect.TypeOf([]RawElement(nil))
+
+func collectDrift(v reflect.Value, path string, report func(string, RawElement)) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collectDrift(v.Index(i), fmt.Sprintf("%s[%d]", path, i), report)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" || f.Name == "XMLName" || f.Tag.Get("xml") == "-" {
+				continue
+			}
+			if f.Type == rawElementsType && f.Tag.Get("xml") == ",any" {
+				where := path
+				if where == "" {
+					where = t.Name()
+				}
+				for _, element := range v.Field(i).Interface().([]RawElement) {
+					report(where, element)
+				}
+				continue
+			}
+			if f.Anonymous {
+				collectDrift(v.Field(i), path, report)
+				continue
+			}
+			name := xmlFieldName(f)
+			if path != "" {
+				name = path + "." + name
+			}
+			collectDrift(v.Field(i), name, report)
+		}
+	}
+}
+
+// xmlFieldName returns the local element name of a struct field.
+func xmlFieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("xml"), ",")[0]
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name
+}
@@ -0,0 +1,77 @@
+package gopowerschool
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const driftReply = `<?xml version="1.0" encoding="UTF-8"?>` +
+	`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body>` +
+	`<ns:getStudentDataResponse xmlns:ns="http://publicportal.rest.powerschool.pearson.com/xsd"><ns:return>` +
+	`<ns:userSessionVO><ns:serviceTicket>ticket</ns:serviceTicket><ns:futureField>1</ns:futureField></ns:userSessionVO>` +
+	`<ns:studentDataVOs><ns:student><ns:firstName>Ada</ns:firstName><ns:pronouns>she/her</ns:pronouns></ns:student></ns:studentDataVOs>` +
+	`</ns:return></ns:getStudentDataResponse>` +
+	`<ns:serverNotice xmlns:ns="urn:notice">maintenance tonight</ns:serverNotice>` +
+	`</soapenv:Body></soapenv:Envelope>`
+
+func TestLenientDecoding(t *testing.T) {
+	var resent string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		resent = string(body)
+		io.WriteString(w, driftReply)
+	}))
+	defer server.Close()
+
+	var drift []string
+	client, err := NewClient(server.URL, WithLenientDecoding(func(d SchemaDrift) {
+		drift = append(drift, d.String())
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := client.GetStudentData(&GetStudentData{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"urn:getStudentData: unknown element <pronouns> in return.studentDataVOs[0].student",
+		"urn:getStudentData: unknown element <futureField> in return.userSessionVO",
+		"urn:getStudentData: unknown element <serverNotice> in Body",
+	}
+	if fmt.Sprint(drift) != fmt.Sprint(want) {
+		t.Errorf("drift = %q, want %q", drift, want)
+	}
+	session := response.Return_.UserSessionVO
+	if session.ServiceTicket != "ticket" || len(session.Extra) != 1 || session.Extra[0].Inner != "1" {
+		t.Errorf("session = %+v", session)
+	}
+	if name := response.Return_.StudentDataVOs[0].Student.FirstName; name != "Ada" {
+		t.Errorf("first name = %q", name)
+	}
+
+	// Unknown elements are kept for the caller, not sent back.
+	if _, err := client.GetStudentData(&GetStudentData{UserSessionVO: session}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resent, "<userSessionVO><serviceTicket>ticket</serviceTicket></userSessionVO>") {
+		t.Errorf("session re-sent as\n%s", resent)
+	}
+}
+
+func TestStrictDecodingRejectsExtraBodyElements(t *testing.T) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		io.WriteString(w, driftReply)
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetStudentData(&GetStudentData{}); err == nil {
+		t.Error("strict decoding accepted a second body element")
+	}
+}
@@ -0,0 +1,224 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// WithLogger logs every call to logger. At info level each call is summarized
+// with its SOAP action, HTTP status, duration and MessageVO codes. At debug
+// level the request and response envelopes and headers are logged too, with
+// passwords, service tickets and the digest Authorization header redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *clientConfig) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// LoggingInterceptor returns the interceptor installed by WithLogger.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return func(call *SOAPCall, next Invoker) error {
+		ctx := call.Context
+		if logger.Enabled(ctx, slog.LevelDebug) {
+			call.CaptureResponse = true
+			logger.DebugContext(ctx, "soap request",
+				slog.String("action", call.Action),
+				slog.String("envelope", string(RedactEnvelope(call.Envelope))))
+		}
+
+		start := time.Now()
+		err := next(call)
+		duration := time.Since(start)
+
+		status := 0
+		if call.HTTPResponse != nil {
+			status = call.HTTPResponse.StatusCode
+		}
+		if logger.Enabled(ctx, slog.LevelDebug) && call.HTTPResponse != nil {
+			var requestHeader http.Header
+			if call.HTTPResponse.Request != nil {
+				requestHeader = call.HTTPResponse.Request.Header
+			}
+			logger.DebugContext(ctx, "soap response",
+				slog.String("action", call.Action),
+				slog.Any("request_header", redactHeader(requestHeader)),
+				slog.Any("response_header", redactHeader(call.HTTPResponse.Header)),
+				slog.String("envelope", string(RedactEnvelope(call.ResponseEnvelope))))
+		}
+
+		attrs := []slog.Attr{
+			slog.String("action", call.Action),
+			slog.Int("status", status),
+			slog.Duration("duration", duration),
+			slog.Int("attempts", call.Attempts),
+		}
+		if codes := messageCodes(call.Response); len(codes) > 0 {
+			attrs = append(attrs, slog.Any("msg_codes", codes))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+			logger.LogAttrs(ctx, slog.LevelWarn, "soap call failed", attrs...)
+		} else {
+			logger.LogAttrs(ctx, slog.LevelInfo, "soap call", attrs...)
+		}
+		return err
+	}
+}
+
+var (
+	secretElement = regexp.MustCompile(`(?i)<((?:[\w.-]+:)?(?:password|newPassword|serviceTicket|recoveryToken))[\s/>]`)
+	secretMember  = regexp.MustCompile(`(?i)("(?:password|newPassword|serviceTicket|recoveryToken)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// RedactEnvelope replaces the content of password, service ticket and
+// recovery token elements in a SOAP envelope or JSON request or response.
+// Element content is redacted up to the matching end tag, CDATA sections
+// and child elements included; an element left open redacts the rest of
+// the envelope.
+func RedactEnvelope(envelope []byte) []byte {
+	var clean bytes.Buffer
+	for {
+		match := secretElement.FindSubmatchIndex(envelope)
+		if match == nil {
+			break
+		}
+		name := string(envelope[match[2]:match[3]])
+		start := bytes.IndexByte(envelope[match[0]:], '>')
+		if start < 0 {
+			break
+		}
+		start += match[0] + 1
+		empty := envelope[start-2] == '/'
+		clean.Write(envelope[:start])
+		envelope = envelope[start:]
+		if empty {
+			continue
+		}
+		clean.WriteString(redacted)
+		end := endTag(envelope, name)
+		if end < 0 {
+			return clean.Bytes()
+		}
+		envelope = envelope[end:]
+	}
+	clean.Write(envelope)
+	return secretMember.ReplaceAll(clean.Bytes(), []byte(`${1}"`+redacted+`"`))
+}
+
+// endTag returns the offset in content of the end tag closing an element
+// named name, skipping CDATA sections and nested elements of the same
+// name, or -1.
+func endTag(content []byte, name string) int {
+	depth := 0
+	for i := 0; ; {
+		j := bytes.IndexByte(content[i:], '<')
+		if j < 0 {
+			return -1
+		}
+		i += j
+		rest := content[i:]
+		switch {
+		case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+			k := bytes.Index(rest, []byte("]]>"))
+			if k < 0 {
+				return -1
+			}
+			i += k + len("]]>")
+			continue
+		case hasTagName(rest[min(2, len(rest)):], name) && rest[1] == '/':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case hasTagName(rest[1:], name):
+			if k := bytes.IndexByte(rest, '>'); k > 0 && rest[k-1] != '/' {
+				depth++
+			}
+		}
+		i++
+	}
+}
+
+// hasTagName reports whether b starts with the tag name name, compared
+// without case.
+func hasTagName(b []byte, name string) bool {
+	if len(b) <= len(name) || !bytes.EqualFold(b[:len(name)], []byte(name)) {
+		return false
+	}
+	switch b[len(name)] {
+	case '>', '/', ' ', '\t', '\r', '\n':
+		return true
+	}
+	return false
+}
+
+var secretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+func redactHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	clean := header.Clone()
+	for _, key := range secretHeaders {
+		if _, ok := clean[key]; ok {
+			clean[key] = []string{redacted}
+		}
+	}
+	return clean
+}
+
+// messageCodes returns the msgCode of every MessageVO returned in a decoded
+// response.
+func messageCodes(response interface{}) []int32 {
+	var codes []int32
+	for _, message := range responseMessages(response) {
+		codes = append(codes, message.MsgCode)
+	}
+	return codes
+}
+
+// responseMessages returns the MessageVOs returned in a decoded response.
+func responseMessages(response interface{}) []*MessageVO {
+	value := reflect.ValueOf(response)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	result := value.Elem().FieldByName("Return_")
+	if !result.IsValid() || result.Kind() != reflect.Ptr || result.IsNil() {
+		return nil
+	}
+	var messages []*MessageVO
+	switch vo := result.Interface().(type) {
+	case *MessageVO:
+		messages = []*MessageVO{vo}
+	case *ResultsVO:
+		messages = vo.MessageVOs
+	case *PasswordResetVO:
+		if vo.BaseResultsVO != nil {
+			messages = vo.BaseResultsVO.MessageVOs
+		}
+	case *CredentialComplexityRulesVO:
+		if vo.BaseResultsVO != nil {
+			messages = vo.BaseResultsVO.MessageVOs
+		}
+	}
+	var present []*MessageVO
+	for _, message := range messages {
+		if message != nil {
+			present = append(present, message)
+		}
+	}
+	return present
+}
+
+// isErrorMessage reports whether a MessageVO reports an error rather than
+// informs. MessageVO carries no message type, so only messages with a
+// nonzero msgCode count as errors.
+func isErrorMessage(message *MessageVO) bool {
+	return message != nil && message.MsgCode != 0
+}
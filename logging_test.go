@@ -0,0 +1,104 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactEnvelope(t *testing.T) {
+	for _, test := range []struct {
+		name, envelope, want string
+	}{
+		{
+			"text",
+			`<login><username>student</username><password>hunter2</password></login>`,
+			`<login><username>student</username><password>[REDACTED]</password></login>`,
+		},
+		{
+			"prefixed",
+			`<ns:login xmlns:ns="x"><ns:password type="plain">hunter2</ns:password></ns:login>`,
+			`<ns:login xmlns:ns="x"><ns:password type="plain">[REDACTED]</ns:password></ns:login>`,
+		},
+		{
+			"case",
+			`<NewPassword>hunter2</NewPassword><recoverytoken>token</recoverytoken>`,
+			`<NewPassword>[REDACTED]</NewPassword><recoverytoken>[REDACTED]</recoverytoken>`,
+		},
+		{
+			"cdata",
+			`<password><![CDATA[hunter2<a></password>]]></password><userType>2</userType>`,
+			`<password>[REDACTED]</password><userType>2</userType>`,
+		},
+		{
+			"nested",
+			`<serviceTicket><part>one</part><serviceTicket>two</serviceTicket>three</serviceTicket><userId>1</userId>`,
+			`<serviceTicket>[REDACTED]</serviceTicket><userId>1</userId>`,
+		},
+		{
+			"empty",
+			`<password/><username>student</username><password></password>`,
+			`<password/><username>student</username><password>[REDACTED]</password>`,
+		},
+		{
+			"unterminated",
+			`<password>hunter2<username>student</username>`,
+			`<password>[REDACTED]`,
+		},
+		{
+			"similar names",
+			`<passwordHint>pet's name</passwordHint><serviceTicketExpiry>1</serviceTicketExpiry>`,
+			`<passwordHint>pet's name</passwordHint><serviceTicketExpiry>1</serviceTicketExpiry>`,
+		},
+		{
+			"json",
+			`{"login": {"username": "student", "password": "hunt\"er2", "newPassword":"x"}}`,
+			`{"login": {"username": "student", "password": "[REDACTED]", "newPassword":"[REDACTED]"}}`,
+		},
+		{
+			"json session",
+			`{"userSessionVO": {"serviceTicket" : "ticket", "userId": 1}}`,
+			`{"userSessionVO": {"serviceTicket" : "[REDACTED]", "userId": 1}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(RedactEnvelope([]byte(test.envelope))); got != test.want {
+				t.Errorf("RedactEnvelope(%s)\n= %s\nwant %s", test.envelope, got, test.want)
+			}
+		})
+	}
+}
+
+// Debug logging must show envelopes and headers without their secrets.
+func TestLoggerRedacts(t *testing.T) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "cookie-secret"})
+		io.WriteString(w, soapResponse("loginToPublicPortal", "<userSessionVO><serviceTicket>ticket-secret</serviceTicket><userType>2</userType></userSessionVO>"))
+	}))
+	defer server.Close()
+	var out bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClient(server.URL, WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.LoginToPublicPortal(&LoginToPublicPortal{Username: "student", Password: "password-secret"}); err != nil {
+		t.Fatal(err)
+	}
+	logged := out.String()
+	for _, secret := range []string{"password-secret", "ticket-secret", "cookie-secret", "Digest username"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log contains %q:\n%s", secret, logged)
+		}
+	}
+	for _, message := range []string{"msg=\"soap request\"", "msg=\"soap response\"", "msg=\"soap call\"", "student", "Authorization:[[REDACTED]]"} {
+		if !strings.Contains(logged, message) {
+			t.Errorf("log is missing %q:\n%s", message, logged)
+		}
+	}
+}
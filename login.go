@@ -0,0 +1,73 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Session is a logged in session.
+type Session struct {
+	// UserSessionVO is passed to calls made in the session.
+	UserSessionVO *UserSessionVO
+	// UserType is the kind of account the server logged in.
+	UserType UserType
+	// Locale is the account's locale, or nil if the server sends none.
+	Locale *Locale
+	// StudentIDs are the students the account can see, which is none for
+	// teachers.
+	StudentIDs []int64
+}
+
+// LoginAs logs in to an account of userType with a username and password;
+// see LoginAsFrom.
+func (client *PublicPortalServiceJSONPortType) LoginAs(username, password string, userType UserType) (*Session, error) {
+	return client.LoginAsFrom(StaticCredentials{Username: username, Password: password}, userType)
+}
+
+// LoginAsFrom logs in to an account of userType. Guardians and students
+// log in to the public portal with LoginToPublicPortal, as the mobile app
+// does; other accounts, such as teachers, log in with Login. A userType of
+// 0 logs in to the public portal and accepts any kind of account;
+// otherwise logging in to another kind of account is an error, and the
+// session the server opened for it is logged out.
+func (client *PublicPortalServiceJSONPortType) LoginAsFrom(provider CredentialProvider, userType UserType) (*Session, error) {
+	creds, err := provider.Credentials()
+	if err != nil {
+		return nil, err
+	}
+	var results *ResultsVO
+	switch userType {
+	case 0, UserTypeGuardian, UserTypeStudent:
+		response, err := client.LoginToPublicPortal(&LoginToPublicPortal{Username: creds.Username, Password: creds.Password})
+		if err != nil {
+			return nil, err
+		}
+		results = response.Return_
+	default:
+		response, err := client.Login(&Login{Username: creds.Username, Password: creds.Password, UserType: int32(userType)})
+		if err != nil {
+			return nil, err
+		}
+		results = response.Return_
+	}
+	if results != nil && len(results.MessageVOs) > 0 {
+		return nil, fmt.Errorf("error: %s - %s", results.MessageVOs[0].Title, results.MessageVOs[0].Description)
+	}
+	if results == nil || results.UserSessionVO == nil {
+		return nil, fmt.Errorf("error: no session returned")
+	}
+
+	vo := results.UserSessionVO
+	session := &Session{UserSessionVO: vo, UserType: UserType(vo.UserType), Locale: vo.Locale}
+	if userType != 0 && session.UserType != 0 && session.UserType != userType {
+		err := fmt.Errorf("error: logged in to a %s account, not a %s account", session.UserType, userType)
+		if _, logoutErr := client.Logout(&Logout{UserSessionVO: vo}); logoutErr != nil {
+			err = errors.Join(err, fmt.Errorf("logging out: %w", logoutErr))
+		}
+		return nil, err
+	}
+	for _, id := range vo.StudentIDs {
+		session.StudentIDs = append(session.StudentIDs, int64(id))
+	}
+	return session, nil
+}
@@ -0,0 +1,43 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Logging in to the wrong kind of account must not leave its session open.
+func TestLoginAsLogsOutOnUserTypeMismatch(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		action := r.Header.Get("SOAPAction")
+		actions = append(actions, action)
+		switch action {
+		case "urn:loginToPublicPortal":
+			io.WriteString(w, soapResponse("loginToPublicPortal", `<ax:userSessionVO xmlns:ax="http://vo.rest.powerschool.pearson.com/xsd">`+
+				`<ax:serviceTicket>ticket</ax:serviceTicket><ax:userType>1</ax:userType><ax:futureField>1</ax:futureField></ax:userSessionVO>`))
+		case "urn:logout":
+			want := `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+				`<logout xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userSessionVO><serviceTicket>ticket</serviceTicket><userType>1</userType></userSessionVO></logout>` +
+				`</Body></Envelope>`
+			if string(body) != want {
+				t.Errorf("logout request\n%s\nwant\n%s", body, want)
+			}
+			io.WriteString(w, soapResponse("logout", ""))
+		}
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.LoginAs("parent", "password", UserTypeStudent); err == nil {
+		t.Fatal("LoginAs accepted a guardian account as a student")
+	}
+	if strings.Join(actions, " ") != "urn:loginToPublicPortal urn:logout" {
+		t.Errorf("calls made: %v", actions)
+	}
+}
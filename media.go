@@ -0,0 +1,143 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MediaCache keeps downloaded photos and school maps on disk along with the
+// modification date the server reported for them, so that each is
+// downloaded again only when that date changes. It is safe for concurrent
+// use by multiple goroutines and processes sharing Dir.
+type MediaCache struct {
+	Dir string
+}
+
+// mediaEntry heads a cache file: the file holds the entry as a line of
+// JSON followed by the data, so that both are replaced in one rename.
+type mediaEntry struct {
+	Version     string `json:"version"`
+	ContentType string `json:"contentType"`
+	Size        int    `json:"size"`
+}
+
+// load returns the cached data for key if it was stored for version.
+func (c *MediaCache) load(key, version string) ([]byte, string, bool) {
+	if c == nil || version == "" {
+		return nil, "", false
+	}
+	file, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, "", false
+	}
+	header, data, ok := bytes.Cut(file, []byte("\n"))
+	if !ok {
+		return nil, "", false
+	}
+	var entry mediaEntry
+	if err := json.Unmarshal(header, &entry); err != nil || entry.Version != version || entry.Size != len(data) {
+		return nil, "", false
+	}
+	return data, entry.ContentType, true
+}
+
+// store caches data for key as of version. Data without a version is not
+// cached, since there would be no way to tell when it changes.
+func (c *MediaCache) store(key, version, contentType string, data []byte) error {
+	if c == nil || version == "" {
+		return nil
+	}
+	header, err := json.Marshal(mediaEntry{Version: version, ContentType: contentType, Size: len(data)})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+	file := make([]byte, 0, len(header)+1+len(data))
+	file = append(append(append(file, header...), '\n'), data...)
+	return writeFileAtomic(c.path(key), file)
+}
+
+// evict removes the entry for key.
+func (c *MediaCache) evict(key string) error {
+	if c == nil {
+		return nil
+	}
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (c *MediaCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:12]))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ErrNoMedia is returned when the server has no photo or map to send.
+var ErrNoMedia = errors.New("no media returned")
+
+// decodeMedia returns the bytes of a photo or map payload. Over SOAP the
+// payload is the base64 text of the file; the JSON encoding has already
+// decoded it, so a payload in any format mediaType recognizes is returned
+// as it is.
+func decodeMedia(payload []byte) ([]byte, error) {
+	if mediaType(payload) != "" {
+		return payload, nil
+	}
+	if len(bytes.TrimSpace(payload)) == 0 {
+		return nil, ErrNoMedia
+	}
+	text := bytes.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, payload)
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(data, text)
+	if err != nil {
+		return nil, fmt.Errorf("media is neither a known format nor base64: %w", err)
+	}
+	return data[:n], nil
+}
+
+// mediaType recognizes the formats photos and maps are served in: the
+// images and PDFs http.DetectContentType knows by their signature, and
+// TIFF images.
+func mediaType(data []byte) string {
+	switch detected := http.DetectContentType(data); {
+	case strings.HasPrefix(detected, "image/") || detected == "application/pdf":
+		return detected
+	case bytes.HasPrefix(data, []byte("II*\x00")) || bytes.HasPrefix(data, []byte("MM\x00*")):
+		return "image/tiff"
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"os"
+	"testing"
+)
+
+func TestDecodeMedia(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n....")
+	encoded := base64.StdEncoding.EncodeToString(png)
+	for _, payload := range [][]byte{png, []byte(encoded), []byte(encoded[:8] + "\n" + encoded[8:])} {
+		data, err := decodeMedia(payload)
+		if err != nil || !bytes.Equal(data, png) {
+			t.Errorf("decodeMedia(%q) = %q, %v", payload, data, err)
+		}
+	}
+	// Raw files of other formats are not mistaken for base64.
+	for _, raw := range [][]byte{[]byte("GIF89a\x01\x00\x01\x00"), []byte("II*\x00\x08\x00"), []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")} {
+		if data, err := decodeMedia(raw); err != nil || !bytes.Equal(data, raw) {
+			t.Errorf("decodeMedia(%q) = %q, %v", raw, data, err)
+		}
+	}
+	if _, err := decodeMedia([]byte("<html>not found</html>")); err == nil {
+		t.Error("decodeMedia accepted a payload that is not media")
+	}
+}
+
+func TestMediaCache(t *testing.T) {
+	cache := &MediaCache{Dir: t.TempDir()}
+	if err := cache.store("map", "v1", "image/png", []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	if data, contentType, ok := cache.load("map", "v1"); !ok || string(data) != "first" || contentType != "image/png" {
+		t.Errorf("load = %q, %q, %v", data, contentType, ok)
+	}
+	if _, _, ok := cache.load("map", "v2"); ok {
+		t.Error("load served an entry stored for another version")
+	}
+
+	// Data and version are replaced together.
+	if err := cache.store("map", "v2", "application/pdf", []byte("second\nline")); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := cache.load("map", "v1"); ok {
+		t.Error("load served replaced data for the old version")
+	}
+	if data, contentType, ok := cache.load("map", "v2"); !ok || string(data) != "second\nline" || contentType != "application/pdf" {
+		t.Errorf("load = %q, %q, %v", data, contentType, ok)
+	}
+
+	// A truncated entry is a miss.
+	file, err := os.ReadFile(cache.path("map"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cache.path("map"), file[:len(file)-1], 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := cache.load("map", "v2"); ok {
+		t.Error("load served a truncated entry")
+	}
+
+	if err := cache.evict("map"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.evict("map"); err != nil {
+		t.Errorf("evicting a missing entry: %v", err)
+	}
+	if entries, _ := os.ReadDir(cache.Dir); len(entries) != 0 {
+		t.Errorf("cache holds %d files after eviction", len(entries))
+	}
+	if err := (*MediaCache)(nil).store("map", "v1", "image/png", []byte("data")); err != nil {
+		t.Errorf("store on a nil cache: %v", err)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	// Four columns: black, white, red and blue, over two rows.
+	src := image.NewRGBA(image.Rect(10, 10, 14, 12))
+	for y := 10; y < 12; y++ {
+		src.Set(10, y, color.RGBA{0, 0, 0, 255})
+		src.Set(11, y, color.RGBA{255, 255, 255, 255})
+		src.Set(12, y, color.RGBA{255, 0, 0, 255})
+		src.Set(13, y, color.RGBA{0, 0, 255, 255})
+	}
+
+	dst := thumbnail(src, 2)
+	if bounds := dst.Bounds(); bounds != image.Rect(0, 0, 2, 1) {
+		t.Fatalf("thumbnail bounds = %v, want 2x1", bounds)
+	}
+	for x, want := range []color.RGBA64{
+		{R: 0x7f7f, G: 0x7f7f, B: 0x7f7f, A: 0xffff},
+		{R: 0x7f7f, G: 0, B: 0x7f7f, A: 0xffff},
+	} {
+		if got := color.RGBA64Model.Convert(dst.At(x, 0)).(color.RGBA64); got != want {
+			t.Errorf("pixel %d = %v, want the average %v", x, got, want)
+		}
+	}
+
+	if tall := thumbnail(image.NewRGBA(image.Rect(0, 0, 10, 40)), 8); tall.Bounds() != image.Rect(0, 0, 2, 8) {
+		t.Errorf("tall thumbnail bounds = %v, want 2x8", tall.Bounds())
+	}
+	if thumbnail(src, 4) != image.Image(src) || thumbnail(src, 0) != image.Image(src) {
+		t.Error("an image that fits was scaled")
+	}
+}
@@ -0,0 +1,235 @@
+package gopowerschool
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultDurationBuckets are the histogram buckets, in seconds, used for
+// MetricCallDuration.
+var DefaultDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// DefaultSizeBuckets are the histogram buckets, in bytes, used for payload
+// size metrics.
+var DefaultSizeBuckets = []float64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20}
+
+var metricHelp = map[string]string{
+	MetricCalls:         "SOAP calls made to PowerSchool.",
+	MetricErrors:        "Failed SOAP calls and MessageVO errors by reason.",
+	MetricCallDuration:  "Duration of SOAP calls, including retries.",
+	MetricRequestBytes:  "Size of SOAP request envelopes.",
+	MetricResponseBytes: "Size of SOAP response envelopes.",
+}
+
+// MetricsRegistry is an in-memory Metrics implementation that can be
+// exported in the Prometheus text format. It is safe for concurrent use.
+type MetricsRegistry struct {
+	mu         sync.Mutex
+	buckets    map[string][]float64
+	counters   map[string]map[string]*counterSeries
+	histograms map[string]map[string]*histogramSeries
+}
+
+type counterSeries struct {
+	labels map[string]string
+	value  float64
+}
+
+type histogramSeries struct {
+	labels map[string]string
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewMetricsRegistry returns an empty registry.
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{
+		buckets: map[string][]float64{
+			MetricCallDuration:  DefaultDurationBuckets,
+			MetricRequestBytes:  DefaultSizeBuckets,
+			MetricResponseBytes: DefaultSizeBuckets,
+		},
+		counters:   map[string]map[string]*counterSeries{},
+		histograms: map[string]map[string]*histogramSeries{},
+	}
+}
+
+// SetBuckets sets the upper bounds used for the histogram name. Series
+// already observed with other buckets cannot be converted, so they are
+// dropped; call it before the first observation to keep them all.
+func (r *MetricsRegistry) SetBuckets(name string, buckets []float64) {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	r.mu.Lock()
+	r.buckets[name] = sorted
+	delete(r.histograms, name)
+	r.mu.Unlock()
+}
+
+func (r *MetricsRegistry) IncCounter(name string, labels map[string]string) {
+	key := labelKey(labels)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	series := r.counters[name]
+	if series == nil {
+		series = map[string]*counterSeries{}
+		r.counters[name] = series
+	}
+	counter := series[key]
+	if counter == nil {
+		counter = &counterSeries{labels: copyLabels(labels)}
+		series[key] = counter
+	}
+	counter.value++
+}
+
+func (r *MetricsRegistry) ObserveHistogram(name string, value float64, labels map[string]string) {
+	key := labelKey(labels)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	buckets, ok := r.buckets[name]
+	if !ok {
+		buckets = DefaultDurationBuckets
+		r.buckets[name] = buckets
+	}
+	series := r.histograms[name]
+	if series == nil {
+		series = map[string]*histogramSeries{}
+		r.histograms[name] = series
+	}
+	histogram := series[key]
+	if histogram == nil {
+		histogram = &histogramSeries{labels: copyLabels(labels), counts: make([]uint64, len(buckets))}
+		series[key] = histogram
+	}
+	for i, bound := range buckets {
+		if value <= bound {
+			histogram.counts[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += value
+}
+
+// Counter returns the current value of a counter series, for tests.
+func (r *MetricsRegistry) Counter(name string, labels map[string]string) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if counter := r.counters[name][labelKey(labels)]; counter != nil {
+		return counter.value
+	}
+	return 0
+}
+
+// WritePrometheus writes every series in the Prometheus text exposition
+// format, sorted by metric name and labels.
+func (r *MetricsRegistry) WritePrometheus(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := bufio.NewWriter(w)
+
+	for _, name := range sortedKeys(r.counters) {
+		writeHeader(out, name, "counter")
+		series := r.counters[name]
+		for _, key := range sortedKeys(series) {
+			counter := series[key]
+			fmt.Fprintf(out, "%s%s %s\n", name, formatLabels(counter.labels, "", ""), formatFloat(counter.value))
+		}
+	}
+	for _, name := range sortedKeys(r.histograms) {
+		writeHeader(out, name, "histogram")
+		buckets := r.buckets[name]
+		series := r.histograms[name]
+		for _, key := range sortedKeys(series) {
+			histogram := series[key]
+			for i, bound := range buckets {
+				fmt.Fprintf(out, "%s_bucket%s %d\n", name, formatLabels(histogram.labels, "le", formatFloat(bound)), histogram.counts[i])
+			}
+			fmt.Fprintf(out, "%s_bucket%s %d\n", name, formatLabels(histogram.labels, "le", "+Inf"), histogram.count)
+			fmt.Fprintf(out, "%s_sum%s %s\n", name, formatLabels(histogram.labels, "", ""), formatFloat(histogram.sum))
+			fmt.Fprintf(out, "%s_count%s %d\n", name, formatLabels(histogram.labels, "", ""), histogram.count)
+		}
+	}
+	return out.Flush()
+}
+
+// ServeHTTP serves the registry to a Prometheus scraper.
+func (r *MetricsRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.WritePrometheus(w)
+}
+
+func writeHeader(out *bufio.Writer, name, kind string) {
+	if help, ok := metricHelp[name]; ok {
+		fmt.Fprintf(out, "# HELP %s %s\n", name, help)
+	}
+	fmt.Fprintf(out, "# TYPE %s %s\n", name, kind)
+}
+
+func formatLabels(labels map[string]string, extraName, extraValue string) string {
+	names := sortedKeys(labels)
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "%s=\"%s\"", name, escapeLabelValue(labels[name]))
+	}
+	if extraName != "" {
+		if len(names) > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "%s=\"%s\"", extraName, extraValue)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func labelKey(labels map[string]string) string {
+	return formatLabels(labels, "", "")
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	c := make(map[string]string, len(labels))
+	for k, v := range labels {
+		c[k] = v
+	}
+	return c
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
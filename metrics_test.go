@@ -0,0 +1,187 @@
+package gopowerschool
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetBucketsAfterObservation(t *testing.T) {
+	registry := NewMetricsRegistry()
+	labels := map[string]string{"action": "urn:getStudentData"}
+	registry.ObserveHistogram(MetricCallDuration, 0.2, labels)
+	registry.SetBuckets(MetricCallDuration, []float64{1, 0.5, 0.1, 2, 5, 10, 20, 30, 60, 120})
+	registry.ObserveHistogram(MetricCallDuration, 0.3, labels)
+
+	var out strings.Builder
+	if err := registry.WritePrometheus(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`powerschool_call_duration_seconds_bucket{action="urn:getStudentData",le="0.1"} 0`,
+		`powerschool_call_duration_seconds_bucket{action="urn:getStudentData",le="0.5"} 1`,
+		`powerschool_call_duration_seconds_bucket{action="urn:getStudentData",le="120"} 1`,
+		`powerschool_call_duration_seconds_count{action="urn:getStudentData"} 1`,
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("missing %s in\n%s", line, out.String())
+		}
+	}
+}
+
+type spanKey struct{}
+
+// recordedSpan is a span of a recordingTracer.
+type recordedSpan struct {
+	name   string
+	parent *recordedSpan
+	attrs  map[string]interface{}
+	errs   []error
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) { s.errs = append(s.errs, err) }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+// recordingTracer keeps every span it starts, in order.
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	parent, _ := ctx.Value(spanKey{}).(*recordedSpan)
+	span := &recordedSpan{name: name, parent: parent, attrs: map[string]interface{}{}}
+	span.SetAttributes(attrs...)
+	r.spans = append(r.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestInstrumentationInterceptor(t *testing.T) {
+	var status int
+	var reply string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		w.WriteHeader(status)
+		io.WriteString(w, reply)
+	}))
+	defer server.Close()
+	tracer := &recordingTracer{}
+	registry := NewMetricsRegistry()
+	client, err := NewClient(server.URL, WithTracer(tracer), WithMetrics(registry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &UserSessionVO{ServerInfo: &ServerInfo{ApiVersion: "23.4.0"}}
+	getStudentData := func() error {
+		_, err := client.GetStudentData(&GetStudentData{UserSessionVO: session})
+		return err
+	}
+
+	// An informational message and an error message.
+	status, reply = http.StatusOK, soapResponse("getStudentData",
+		"<messageVOs><title>Grades are being recalculated</title></messageVOs><messageVOs><msgCode>7</msgCode><title>Unavailable</title></messageVOs>")
+	if err := getStudentData(); err != nil {
+		t.Fatal(err)
+	}
+	status, reply = http.StatusInternalServerError, faultResponse
+	if err := getStudentData(); err == nil {
+		t.Fatal("fault was not returned")
+	}
+	status, reply = http.StatusServiceUnavailable, "down for maintenance"
+	if err := getStudentData(); err == nil {
+		t.Fatal("HTTP error was not returned")
+	}
+
+	action := map[string]string{"action": "urn:getStudentData"}
+	for _, test := range []struct {
+		name   string
+		labels map[string]string
+		want   float64
+	}{
+		{MetricCalls, action, 3},
+		{MetricErrors, map[string]string{"action": "urn:getStudentData", "reason": "7"}, 1},
+		{MetricErrors, map[string]string{"action": "urn:getStudentData", "reason": "0"}, 0},
+		{MetricErrors, map[string]string{"action": "urn:getStudentData", "reason": "soap_fault"}, 1},
+		{MetricErrors, map[string]string{"action": "urn:getStudentData", "reason": "http_503"}, 1},
+	} {
+		if got := registry.Counter(test.name, test.labels); got != test.want {
+			t.Errorf("%s%v = %g, want %g", test.name, test.labels, got, test.want)
+		}
+	}
+
+	var calls []*recordedSpan
+	for _, span := range tracer.spans {
+		if !span.ended {
+			t.Errorf("span %s was not ended", span.name)
+		}
+		switch span.name {
+		case SpanCall:
+			calls = append(calls, span)
+		case SpanDigestChallenge, SpanSOAPRoundTrip:
+			if span.parent == nil || span.parent.name != SpanCall {
+				t.Errorf("span %s is not a child of a call span", span.name)
+			}
+		default:
+			t.Errorf("unexpected span %s", span.name)
+		}
+	}
+	if len(calls) != 3 {
+		t.Fatalf("traced %d calls, want 3", len(calls))
+	}
+	first := calls[0]
+	if first.attrs["soap.action"] != "urn:getStudentData" || first.attrs["powerschool.api_version"] != "23.4.0" ||
+		first.attrs["http.status_code"] != http.StatusOK || len(first.errs) != 0 {
+		t.Errorf("first call span = %+v", first)
+	}
+	if failed := calls[2]; failed.attrs["http.status_code"] != http.StatusServiceUnavailable || len(failed.errs) != 1 {
+		t.Errorf("failed call span = %+v", failed)
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	registry := NewMetricsRegistry()
+	registry.SetBuckets(MetricRequestBytes, []float64{1024, 100})
+	registry.IncCounter(MetricCalls, map[string]string{"action": "urn:logout"})
+	registry.IncCounter(MetricCalls, map[string]string{"action": "urn:getStudentData"})
+	registry.IncCounter(MetricCalls, map[string]string{"action": "urn:getStudentData"})
+	registry.IncCounter("custom_total", map[string]string{"note": "a \"quoted\"\\path\nline"})
+	registry.ObserveHistogram(MetricRequestBytes, 50, nil)
+	registry.ObserveHistogram(MetricRequestBytes, 500, nil)
+	registry.ObserveHistogram(MetricRequestBytes, 5000, nil)
+
+	var out strings.Builder
+	if err := registry.WritePrometheus(&out); err != nil {
+		t.Fatal(err)
+	}
+	want := `# TYPE custom_total counter
+custom_total{note="a \"quoted\"\\path\nline"} 1
+# HELP powerschool_calls_total SOAP calls made to PowerSchool.
+# TYPE powerschool_calls_total counter
+powerschool_calls_total{action="urn:getStudentData"} 2
+powerschool_calls_total{action="urn:logout"} 1
+# HELP powerschool_request_bytes Size of SOAP request envelopes.
+# TYPE powerschool_request_bytes histogram
+powerschool_request_bytes_bucket{le="100"} 1
+powerschool_request_bytes_bucket{le="1024"} 2
+powerschool_request_bytes_bucket{le="+Inf"} 3
+powerschool_request_bytes_sum 5550
+powerschool_request_bytes_count 3
+`
+	if out.String() != want {
+		t.Errorf("WritePrometheus wrote\n%s\nwant\n%s", out.String(), want)
+	}
+
+	recorder := httptest.NewRecorder()
+	registry.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if got := recorder.Header().Get("Content-Type"); got != "text/plain; version=0.0.4; charset=utf-8" || recorder.Body.String() != want {
+		t.Errorf("ServeHTTP served %s:\n%s", got, recorder.Body)
+	}
+}
@@ -0,0 +1,132 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// NotificationFrequency is how often notification emails are sent, as the
+// server's NotificationSettingsVO.Frequency value. The WSDL types it as a
+// plain int and no recorded response names its values, so the package
+// passes it through unchanged: to choose a frequency, use one read with
+// NotificationSettingsOf from settings already set to it.
+type NotificationFrequency int32
+
+// NotificationSettings are the email notifications an account receives
+// about a student.
+type NotificationSettings struct {
+	Frequency NotificationFrequency
+	// MainEmail is the account's own address. It is required when any
+	// notification is selected or EmailAddresses is not empty.
+	MainEmail string
+	// EmailAddresses are further addresses that receive a copy.
+	EmailAddresses []string
+
+	GradeAndAttendanceSummary bool
+	DetailedAssignments       bool
+	DetailedAttendance        bool
+	SchoolAnnouncements       bool
+	BalanceAlerts             bool
+
+	// GuardianStudentID identifies the student the settings are for.
+	GuardianStudentID int64
+}
+
+// NotificationSettingsOf returns the notification settings included in a
+// student's data, or nil if there are none.
+func NotificationSettingsOf(data *StudentDataVO) *NotificationSettings {
+	vo := data.NotificationSettingsVO
+	if vo == nil {
+		return nil
+	}
+	return &NotificationSettings{
+		Frequency:                 NotificationFrequency(vo.Frequency),
+		MainEmail:                 vo.MainEmail,
+		EmailAddresses:            append([]string(nil), vo.EmailAddresses...),
+		GradeAndAttendanceSummary: vo.GradeAndAttSummary,
+		DetailedAssignments:       vo.DetailedAssignments,
+		DetailedAttendance:        vo.DetailedAttendance,
+		SchoolAnnouncements:       vo.SchoolAnnouncements,
+		BalanceAlerts:             vo.BalanceAlerts,
+		GuardianStudentID:         vo.GuardianStudentId,
+	}
+}
+
+// Validate checks that every address is a plain email address given once,
+// and that there is a main email when notifications are to be sent. It
+// returns every problem found, joined.
+func (s *NotificationSettings) Validate() error {
+	var errs []error
+	seen := map[string]bool{}
+	check := func(field, address string) {
+		parsed, err := mail.ParseAddress(address)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%s %q: %w", field, address, err))
+		case parsed.Address != address:
+			errs = append(errs, fmt.Errorf("%s %q: want a bare address such as %q", field, address, parsed.Address))
+		case seen[strings.ToLower(address)]:
+			errs = append(errs, fmt.Errorf("%s %q: listed more than once", field, address))
+		}
+		seen[strings.ToLower(address)] = true
+	}
+	if s.MainEmail != "" {
+		check("main email", s.MainEmail)
+	} else if s.notifies() || len(s.EmailAddresses) > 0 {
+		errs = append(errs, errors.New("a main email is required to send notifications"))
+	}
+	for _, address := range s.EmailAddresses {
+		check("email address", address)
+	}
+	return errors.Join(errs...)
+}
+
+// notifies reports whether any notification is selected.
+func (s *NotificationSettings) notifies() bool {
+	return s.GradeAndAttendanceSummary || s.DetailedAssignments || s.DetailedAttendance ||
+		s.SchoolAnnouncements || s.BalanceAlerts
+}
+
+// NotificationUpdate controls how UpdateNotificationSettings applies
+// settings.
+type NotificationUpdate struct {
+	// ApplyToAllStudents applies the settings to every student of the
+	// account rather than only to GuardianStudentID.
+	ApplyToAllStudents bool
+	// SendNow also sends a notification email right away.
+	SendNow bool
+}
+
+// UpdateNotificationSettings validates settings and stores them. It
+// returns the messages the server responded with, which may report
+// problems even when the call itself succeeds.
+func (client *PublicPortalServiceJSONPortType) UpdateNotificationSettings(session *UserSessionVO, settings *NotificationSettings, update NotificationUpdate) ([]*MessageVO, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	response, err := client.StoreNotificationSettings(&StoreNotificationSettings{
+		UserSessionVO: session,
+		Ns: &NotificationSettingsVO{
+			ApplyToAllStudents:  update.ApplyToAllStudents,
+			BalanceAlerts:       settings.BalanceAlerts,
+			DetailedAssignments: settings.DetailedAssignments,
+			DetailedAttendance:  settings.DetailedAttendance,
+			EmailAddresses:      settings.EmailAddresses,
+			Frequency:           int32(settings.Frequency),
+			GradeAndAttSummary:  settings.GradeAndAttendanceSummary,
+			GuardianStudentId:   settings.GuardianStudentID,
+			MainEmail:           settings.MainEmail,
+			SchoolAnnouncements: settings.SchoolAnnouncements,
+			SendNow:             update.SendNow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Return_ == nil {
+		return nil, nil
+	}
+	return response.Return_.MessageVOs, nil
+}
@@ -0,0 +1,90 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNotificationSettingsValidate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		settings NotificationSettings
+		errs     []string
+	}{
+		{"valid", NotificationSettings{MainEmail: "parent@example.com", EmailAddresses: []string{"grandparent@example.com"}, DetailedAssignments: true}, nil},
+		{"nothing sent", NotificationSettings{Frequency: 2}, nil},
+		{"no main email", NotificationSettings{SchoolAnnouncements: true}, []string{"a main email is required"}},
+		{"copies without a main email", NotificationSettings{EmailAddresses: []string{"grandparent@example.com"}}, []string{"a main email is required"}},
+		{"not an address", NotificationSettings{MainEmail: "parent"}, []string{`main email "parent"`}},
+		{"display name", NotificationSettings{MainEmail: "Parent <parent@example.com>"}, []string{`want a bare address such as "parent@example.com"`}},
+		{
+			"repeated",
+			NotificationSettings{MainEmail: "parent@example.com", EmailAddresses: []string{"Parent@example.com", "other", "grandparent@example.com"}},
+			[]string{`email address "Parent@example.com": listed more than once`, `email address "other"`},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.settings.Validate()
+			if test.errs == nil {
+				if err != nil {
+					t.Errorf("Validate() = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() = nil")
+			}
+			if lines := strings.Split(err.Error(), "\n"); len(lines) != len(test.errs) {
+				t.Errorf("Validate() = %v, want %d problems", err, len(test.errs))
+			}
+			for _, want := range test.errs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() = %v, want it to mention %s", err, want)
+				}
+			}
+		})
+	}
+}
+
+// Settings read from a student are stored with the frequency value they
+// were read with.
+func TestUpdateNotificationSettings(t *testing.T) {
+	var stored string
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		stored = string(body)
+		io.WriteString(w, soapResponse("storeNotificationSettings", "<messageVOs><title>Settings saved</title></messageVOs>"))
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := NotificationSettingsOf(&StudentDataVO{NotificationSettingsVO: &NotificationSettingsVO{
+		Frequency:         3,
+		MainEmail:         "parent@example.com",
+		GuardianStudentId: 7,
+	}})
+
+	settings.DetailedAttendance = true
+	messages, err := client.UpdateNotificationSettings(&UserSessionVO{}, settings, NotificationUpdate{ApplyToAllStudents: true, SendNow: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0].Title != "Settings saved" {
+		t.Errorf("messages = %v", messages)
+	}
+	want := `<ns><applyToAllStudents>true</applyToAllStudents><detailedAttendance>true</detailedAttendance><frequency>3</frequency>` +
+		`<guardianStudentId>7</guardianStudentId><mainEmail>parent@example.com</mainEmail><sendNow>true</sendNow></ns>`
+	if !strings.Contains(stored, want) {
+		t.Errorf("stored\n%s\nwant it to contain\n%s", stored, want)
+	}
+
+	settings.MainEmail = ""
+	stored = ""
+	if _, err := client.UpdateNotificationSettings(&UserSessionVO{}, settings, NotificationUpdate{}); err == nil || stored != "" {
+		t.Errorf("invalid settings were stored: %v", err)
+	}
+}
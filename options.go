@@ -0,0 +1,257 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	// DefaultWSDLPath is where PowerSchool serves the public portal service.
+	DefaultWSDLPath = "pearson-rest/services/PublicPortalServiceJSON?wsdl"
+
+	defaultUserAgent = "gowsdl/0.1"
+)
+
+// DefaultDigestAuth is the digest identity used by the PowerSchool mobile app.
+var DefaultDigestAuth = DigestAuth{Login: "pearson", Password: "m0bApP5"}
+
+// ClientOption configures a client built by NewClient.
+type ClientOption func(*clientConfig) error
+
+type clientConfig struct {
+	auth      DigestAuth
+	wsdlPath  string
+	userAgent string
+	insecure  bool
+	rootCAs   *x509.CertPool
+	pins      [][]byte
+	retry     *RetryPolicy
+
+	interceptors []Interceptor
+	logger       *slog.Logger
+	tracer       Tracer
+	metrics      Metrics
+	transport    http.RoundTripper
+	encoding     Encoding
+
+	soapVersion    SOAPVersion
+	soapHeaders    []interface{}
+	soapHeaderFunc func(ctx context.Context, soapAction string) []interface{}
+
+	lenient      bool
+	driftHandler func(SchemaDrift)
+}
+
+// NewClient returns a client for the PowerSchool server at baseURL. TLS
+// certificates are verified unless WithInsecureSkipVerify is given.
+func NewClient(baseURL string, opts ...ClientOption) (*PublicPortalServiceJSONPortType, error) {
+	config := clientConfig{
+		auth:      DefaultDigestAuth,
+		wsdlPath:  DefaultWSDLPath,
+		userAgent: defaultUserAgent,
+	}
+	for _, opt := range opts {
+		if err := opt(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := ServiceURL(baseURL, config.wsdlPath)
+	if err != nil {
+		return nil, err
+	}
+	var interceptors []Interceptor
+	if config.logger != nil {
+		interceptors = append(interceptors, LoggingInterceptor(config.logger))
+	}
+	if config.tracer != nil || config.metrics != nil {
+		interceptors = append(interceptors, InstrumentationInterceptor(config.tracer, config.metrics))
+	}
+	interceptors = append(interceptors, config.interceptors...)
+	httpClient := newHTTPClient(config.tlsConfig())
+	if recorder, ok := config.transport.(*Recorder); ok && recorder.Transport == nil {
+		httpClient = &http.Client{Transport: recording{recorder: recorder, transport: httpClient.Transport}}
+	} else if config.transport != nil {
+		httpClient = &http.Client{Transport: config.transport}
+	}
+	warn := config.driftHandler
+	if warn == nil && config.logger != nil {
+		logger := config.logger
+		warn = func(drift SchemaDrift) {
+			logger.Warn("powerschool schema drift", "action", drift.Action, "path", drift.Path, "element", drift.Element.XMLName.Local)
+		}
+	}
+	auth := config.auth
+	soap := &SOAPClient{
+		url:          url,
+		auth:         &auth,
+		userAgent:    config.userAgent,
+		httpClient:   httpClient,
+		retry:        config.retry,
+		interceptors: interceptors,
+		tracer:       config.tracer,
+		codec: &xmlCodec{
+			version:    config.soapVersion,
+			headers:    config.soapHeaders,
+			headerFunc: config.soapHeaderFunc,
+			lenient:    config.lenient,
+			warn:       warn,
+		},
+	}
+	if config.encoding == EncodingJSON {
+		soap.codec = jsonCodec{}
+		return NewServiceWithTransport(&JSONClient{soap}), nil
+	}
+	return NewServiceWithTransport(soap), nil
+}
+
+// ServiceURL joins a PowerSchool base URL such as "https://example.com" or
+// "https://example.com/district/" with wsdlPath. It fails unless baseURL is an
+// absolute http or https URL.
+func ServiceURL(baseURL, wsdlPath string) (string, error) {
+	if baseURL == "" {
+		return "", errors.New("empty PowerSchool URL")
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid PowerSchool URL %q: %w", baseURL, err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return "", fmt.Errorf("invalid PowerSchool URL %q: scheme must be http or https", baseURL)
+	}
+	if base.Host == "" {
+		return "", fmt.Errorf("invalid PowerSchool URL %q: missing host", baseURL)
+	}
+	if base.RawQuery != "" || base.Fragment != "" {
+		return "", fmt.Errorf("invalid PowerSchool URL %q: unexpected query or fragment", baseURL)
+	}
+	ref, err := url.Parse(wsdlPath)
+	if err != nil || ref.IsAbs() || ref.Host != "" {
+		return "", fmt.Errorf("invalid WSDL path %q", wsdlPath)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/") + "/" + strings.TrimPrefix(ref.Path, "/")
+	base.RawPath = ""
+	base.RawQuery = ref.RawQuery
+	return base.String(), nil
+}
+
+func (c *clientConfig) tlsConfig() *tls.Config {
+	config := &tls.Config{
+		InsecureSkipVerify: c.insecure,
+		RootCAs:            c.rootCAs,
+	}
+	if len(c.pins) > 0 {
+		pins, insecure := c.pins, c.insecure
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			// Only a verified chain is the server's; without verification
+			// the rest of the chain is whatever the peer chose to send, so
+			// only the leaf it proves it holds the key of can be trusted.
+			var certs []*x509.Certificate
+			if insecure {
+				certs = cs.PeerCertificates[:min(1, len(cs.PeerCertificates))]
+			}
+			for _, chain := range cs.VerifiedChains {
+				certs = append(certs, chain...)
+			}
+			for _, cert := range certs {
+				sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+				for _, pin := range pins {
+					if bytes.Equal(sum[:], pin) {
+						return nil
+					}
+				}
+			}
+			return errors.New("tls: no certificate matches a pinned public key")
+		}
+	}
+	return config
+}
+
+// WithDigestAuth sets the HTTP digest identity sent with every call.
+func WithDigestAuth(login, password string) ClientOption {
+	return func(c *clientConfig) error {
+		c.auth = DigestAuth{Login: login, Password: password}
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables TLS certificate verification. Pinned keys
+// are still checked, against the server's own certificate only.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *clientConfig) error {
+		c.insecure = true
+		return nil
+	}
+}
+
+// WithRootCAs verifies server certificates against pool instead of the
+// system roots.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(c *clientConfig) error {
+		c.rootCAs = pool
+		return nil
+	}
+}
+
+// WithRootCAFile adds the PEM certificates in path to the trusted roots: the
+// system roots, or the pool given to WithRootCAs.
+func WithRootCAFile(path string) ClientOption {
+	return func(c *clientConfig) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if c.rootCAs == nil {
+			if c.rootCAs, err = x509.SystemCertPool(); err != nil {
+				c.rootCAs = x509.NewCertPool()
+			}
+		}
+		if !c.rootCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in %s", path)
+		}
+		return nil
+	}
+}
+
+// WithPinnedPublicKeys only accepts servers whose verified certificate chain
+// contains one of the given keys. Pins are base64 SHA-256 hashes of the
+// SubjectPublicKeyInfo, optionally prefixed with "sha256/".
+func WithPinnedPublicKeys(pins ...string) ClientOption {
+	return func(c *clientConfig) error {
+		for _, pin := range pins {
+			sum, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(pin, "sha256/"))
+			if err != nil || len(sum) != sha256.Size {
+				return fmt.Errorf("invalid public key pin %q", pin)
+			}
+			c.pins = append(c.pins, sum)
+		}
+		return nil
+	}
+}
+
+// WithWSDLPath overrides DefaultWSDLPath.
+func WithWSDLPath(path string) ClientOption {
+	return func(c *clientConfig) error {
+		c.wsdlPath = path
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every call.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *clientConfig) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package gopowerschool
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func pin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256/" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func rulesServer(t *testing.T) *httptest.Server {
+	server := httptest.NewUnstartedServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		io.WriteString(w, soapResponse("getCredentialComplexityRules", "<successful>true</successful>"))
+	}))
+	// Rejected handshakes are the point of some tests; keep them quiet.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	return server
+}
+
+func callRules(client *PublicPortalServiceJSONPortType) error {
+	_, err := client.GetCredentialComplexityRules(&GetCredentialComplexityRules{UserType: int32(UserTypeStudent)})
+	return err
+}
+
+func TestPinnedPublicKeys(t *testing.T) {
+	server := rulesServer(t)
+	server.StartTLS()
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	for _, test := range []struct {
+		name    string
+		options []ClientOption
+		ok      bool
+	}{
+		{"verified", []ClientOption{WithRootCAs(pool), WithPinnedPublicKeys(pin(server.Certificate()))}, true},
+		{"insecure", []ClientOption{WithInsecureSkipVerify(), WithPinnedPublicKeys(pin(server.Certificate()))}, true},
+		{"other key", []ClientOption{WithRootCAs(pool), WithPinnedPublicKeys("sha256/" + base64.StdEncoding.EncodeToString(make([]byte, 32)))}, false},
+	} {
+		client, err := NewClient(server.URL, test.options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := callRules(client); (err == nil) != test.ok {
+			t.Errorf("%s: call returned %v", test.name, err)
+		}
+	}
+}
+
+// An unverified peer can send the pinned server's certificate after its own;
+// that must not satisfy the pin.
+func TestPinnedPublicKeysIgnoreUnverifiedChain(t *testing.T) {
+	genuine := rulesServer(t)
+	genuine.StartTLS()
+	genuine.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "attacker"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attacker := rulesServer(t)
+	attacker.TLS = &tls.Config{Certificates: []tls.Certificate{{
+		Certificate: [][]byte{der, genuine.Certificate().Raw},
+		PrivateKey:  key,
+	}}}
+	attacker.StartTLS()
+	defer attacker.Close()
+
+	client, err := NewClient(attacker.URL, WithInsecureSkipVerify(), WithPinnedPublicKeys(pin(genuine.Certificate())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := callRules(client); err == nil || !strings.Contains(err.Error(), "pinned") {
+		t.Errorf("call through a forged chain returned %v, want a pin mismatch", err)
+	}
+}
+
+func TestRootCAFile(t *testing.T) {
+	server := rulesServer(t)
+	server.StartTLS()
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &clientConfig{}
+	if err := WithRootCAFile(path)(config); err != nil {
+		t.Fatal(err)
+	}
+	if system, err := x509.SystemCertPool(); err == nil && config.rootCAs.Equal(system) {
+		t.Error("WithRootCAFile did not add the file's certificate")
+	}
+	client, err := NewClient(server.URL, WithRootCAFile(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := callRules(client); err != nil {
+		t.Errorf("call to a server signed by the file's CA: %v", err)
+	}
+}
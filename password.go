@@ -0,0 +1,146 @@
+package gopowerschool
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// PasswordRule is a requirement of CredentialComplexityRulesVO.
+type PasswordRule string
+
+const (
+	PasswordRuleLength            PasswordRule = "requiredCharacterCount"
+	PasswordRuleLettersAndNumbers PasswordRule = "lettersAndNumRequired"
+	PasswordRuleMixOfCase         PasswordRule = "mixOfCaseRequired"
+	PasswordRuleSpecialCharacter  PasswordRule = "specialCharacterRequired"
+)
+
+// PasswordViolation is a rule a password does not meet.
+type PasswordViolation struct {
+	Rule    PasswordRule
+	Message string
+}
+
+// PasswordViolations lists every rule a password does not meet.
+type PasswordViolations []PasswordViolation
+
+func (violations PasswordViolations) Error() string {
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = violation.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// PasswordPolicy checks passwords locally against a server's rules.
+type PasswordPolicy struct {
+	Rules *CredentialComplexityRulesVO
+}
+
+// Check returns every rule password does not meet, in the order of the
+// PasswordRule constants, or nil. A policy without rules accepts every
+// password. Punctuation and symbols count as special characters; spaces
+// and control characters do not. It is cheap enough to call on every
+// keystroke.
+func (p *PasswordPolicy) Check(password string) PasswordViolations {
+	if p == nil || p.Rules == nil {
+		return nil
+	}
+	var letters, digits, lower, upper, special bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			letters = true
+			lower = lower || unicode.IsLower(r)
+			upper = upper || unicode.IsUpper(r)
+		case unicode.IsDigit(r):
+			digits = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			special = true
+		}
+	}
+	rules := p.Rules
+	var violations PasswordViolations
+	if n := int(rules.RequiredCharacterCount); len([]rune(password)) < n {
+		violations = append(violations, PasswordViolation{PasswordRuleLength, fmt.Sprintf("password must be at least %d characters", n)})
+	}
+	if rules.LettersAndNumRequired && !(letters && digits) {
+		violations = append(violations, PasswordViolation{PasswordRuleLettersAndNumbers, "password must contain letters and numbers"})
+	}
+	if rules.MixOfCaseRequired && !(lower && upper) {
+		violations = append(violations, PasswordViolation{PasswordRuleMixOfCase, "password must mix upper and lower case letters"})
+	}
+	if rules.SpecialCharacterRequired && !special {
+		violations = append(violations, PasswordViolation{PasswordRuleSpecialCharacter, "password must contain a special character"})
+	}
+	return violations
+}
+
+// Validate returns the violations of Check as an error, or nil.
+func (p *PasswordPolicy) Validate(password string) error {
+	if violations := p.Check(password); violations != nil {
+		return violations
+	}
+	return nil
+}
+
+// PasswordPolicies fetches password rules with GetCredentialComplexityRules
+// and caches them per server and user type. The zero value caches rules
+// for as long as it is kept. It is safe for concurrent use by multiple
+// goroutines.
+type PasswordPolicies struct {
+	// TTL is how long rules are kept before they are fetched again. Zero
+	// keeps them forever.
+	TTL time.Duration
+
+	mu       sync.Mutex
+	policies map[passwordPolicyKey]cachedPasswordPolicy
+}
+
+type passwordPolicyKey struct {
+	url      string
+	userType UserType
+}
+
+type cachedPasswordPolicy struct {
+	policy  *PasswordPolicy
+	fetched time.Time
+}
+
+// DefaultPasswordPolicies is a cache shared by callers that need no other.
+var DefaultPasswordPolicies = &PasswordPolicies{TTL: time.Hour}
+
+// Policy returns the password policy of userType accounts on the server
+// of client.
+func (p *PasswordPolicies) Policy(client *PublicPortalServiceJSONPortType, userType UserType) (*PasswordPolicy, error) {
+	key := passwordPolicyKey{url: client.client.URL(), userType: userType}
+	p.mu.Lock()
+	cached, ok := p.policies[key]
+	p.mu.Unlock()
+	if ok && (p.TTL == 0 || time.Since(cached.fetched) < p.TTL) {
+		return cached.policy, nil
+	}
+
+	response, err := client.GetCredentialComplexityRules(&GetCredentialComplexityRules{UserType: int32(userType)})
+	if err != nil {
+		return nil, err
+	}
+	if response.Return_ == nil {
+		return nil, fmt.Errorf("error: no password rules returned for %s accounts", userType)
+	}
+	if base := response.Return_.BaseResultsVO; base != nil && len(base.MessageVOs) > 0 {
+		return nil, fmt.Errorf("error: %s - %s", base.MessageVOs[0].Title, base.MessageVOs[0].Description)
+	}
+	policy := &PasswordPolicy{Rules: response.Return_}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.policies == nil {
+		p.policies = map[passwordPolicyKey]cachedPasswordPolicy{}
+	}
+	p.policies[key] = cachedPasswordPolicy{policy: policy, fetched: time.Now()}
+	return policy, nil
+}
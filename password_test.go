@@ -0,0 +1,74 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// A reply carrying error messages must not be cached as a policy without
+// rules.
+func TestPasswordPoliciesRejectErrorReply(t *testing.T) {
+	reply := "<messagesVO><msgCode>3</msgCode><title>Unavailable</title><description>Try again later.</description></messagesVO>"
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		io.WriteString(w, soapResponse("getCredentialComplexityRules", reply))
+	}))
+	defer server.Close()
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policies := &PasswordPolicies{}
+
+	if _, err := policies.Policy(client, UserTypeStudent); err == nil {
+		t.Error("Policy accepted an error reply")
+	}
+	reply = "<requiredCharacterCount>8</requiredCharacterCount><successful>true</successful>"
+	policy, err := policies.Policy(client, UserTypeStudent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.Rules.RequiredCharacterCount != 8 {
+		t.Errorf("policy requires %d characters, want 8", policy.Rules.RequiredCharacterCount)
+	}
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	rules := &CredentialComplexityRulesVO{
+		RequiredCharacterCount:   8,
+		LettersAndNumRequired:    true,
+		MixOfCaseRequired:        true,
+		SpecialCharacterRequired: true,
+	}
+	for _, test := range []struct {
+		name     string
+		policy   *PasswordPolicy
+		password string
+		want     []PasswordRule
+	}{
+		{"strong", &PasswordPolicy{Rules: rules}, "Secret-123", nil},
+		{"weak", &PasswordPolicy{Rules: rules}, "secret", []PasswordRule{PasswordRuleLength, PasswordRuleLettersAndNumbers, PasswordRuleMixOfCase, PasswordRuleSpecialCharacter}},
+		{"characters, not bytes", &PasswordPolicy{Rules: rules}, "Ünïcödé1€", nil},
+		{"space is not special", &PasswordPolicy{Rules: rules}, "Secret 123", []PasswordRule{PasswordRuleSpecialCharacter}},
+		{"tab is not special", &PasswordPolicy{Rules: rules}, "Secret\t123", []PasswordRule{PasswordRuleSpecialCharacter}},
+		{"symbol", &PasswordPolicy{Rules: rules}, "Secret+123", nil},
+		{"no rules", &PasswordPolicy{}, "", nil},
+		{"no policy", nil, "", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			violations := test.policy.Check(test.password)
+			if len(violations) != len(test.want) {
+				t.Fatalf("Check(%q) = %v, want %v", test.password, violations, test.want)
+			}
+			for i, violation := range violations {
+				if violation.Rule != test.want[i] {
+					t.Errorf("Check(%q) = %v, want %v", test.password, violations, test.want)
+				}
+			}
+			if err := test.policy.Validate(test.password); (err == nil) != (test.want == nil) {
+				t.Errorf("Validate(%q) = %v", test.password, err)
+			}
+		})
+	}
+}
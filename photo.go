@@ -0,0 +1,104 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// Photo is a student photo.
+type Photo struct {
+	// Data holds the image file as served, a JPEG or PNG.
+	Data []byte
+	// ContentType is "image/jpeg" or "image/png".
+	ContentType string
+	Image       image.Image
+}
+
+// DecodePhoto decodes the payload of a GetStudentPhotoResponse, which may
+// be the image file or its base64 text. It returns ErrNoMedia for an empty
+// payload.
+func DecodePhoto(payload []byte) (*Photo, error) {
+	data, err := decodeMedia(payload)
+	if err != nil {
+		return nil, err
+	}
+	contentType := mediaType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, errors.New("photo is not a JPEG or PNG image")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("photo: %w", err)
+	}
+	return &Photo{Data: data, ContentType: contentType, Image: img}, nil
+}
+
+// StudentPhoto downloads the photo of student. When cache is not nil, the
+// photo is served from it as long as student.PhotoDate is unchanged.
+func (client *PublicPortalServiceJSONPortType) StudentPhoto(session *UserSessionVO, student *StudentVO, cache *MediaCache) (*Photo, error) {
+	key := fmt.Sprintf("%s/photo/%d", client.client.URL(), student.Id)
+	if data, _, ok := cache.load(key, student.PhotoDate); ok {
+		if photo, err := DecodePhoto(data); err == nil {
+			return photo, nil
+		}
+	}
+
+	response, err := client.GetStudentPhoto(&GetStudentPhoto{UserSessionVO: session, StudentID: student.Id})
+	if err != nil {
+		return nil, err
+	}
+	photo, err := DecodePhoto(response.Return_)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.store(key, student.PhotoDate, photo.ContentType, photo.Data); err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+// Thumbnail returns the photo scaled down to fit within size by size
+// pixels, keeping its aspect ratio. Photos that already fit are returned
+// as they are.
+func (p *Photo) Thumbnail(size int) image.Image {
+	return thumbnail(p.Image, size)
+}
+
+// thumbnail scales src down with a box filter, averaging the source pixels
+// that fall within each destination pixel.
+func thumbnail(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if size <= 0 || (w <= size && h <= size) {
+		return src
+	}
+	dw, dh := size, size
+	if w > h {
+		dh = max(1, h*size/w)
+	} else {
+		dw = max(1, w*size/h)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := bounds.Min.Y+y*h/dh, bounds.Min.Y+(y+1)*h/dh
+		for x := 0; x < dw; x++ {
+			x0, x1 := bounds.Min.X+x*w/dw, bounds.Min.X+(x+1)*w/dw
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,101 @@
+package gopowerschool
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AccountRecovery recovers the username or password of accounts of one
+// user type. A password is recovered in two steps: SendResetEmail mails a
+// recovery token to the account, and ResetPassword sets a new password
+// with it.
+type AccountRecovery struct {
+	client   *PublicPortalServiceJSONPortType
+	userType UserType
+	policies *PasswordPolicies
+}
+
+// AccountRecovery returns the recovery flow for accounts of userType. New
+// passwords are checked against the rules cached in policies, such as
+// DefaultPasswordPolicies.
+func (client *PublicPortalServiceJSONPortType) AccountRecovery(userType UserType, policies *PasswordPolicies) *AccountRecovery {
+	return &AccountRecovery{client: client, userType: userType, policies: policies}
+}
+
+// SendUsername mails the usernames of the accounts registered with email.
+// It returns the server's message about the request.
+func (r *AccountRecovery) SendUsername(email string) (*MessageVO, error) {
+	response, err := r.client.RecoverUsername(&RecoverUsername{EmailAddress: email})
+	if err != nil {
+		return nil, err
+	}
+	return response.Return_, nil
+}
+
+// SendResetEmail mails a recovery token to the account with username and
+// email. It returns the server's message about the request.
+func (r *AccountRecovery) SendResetEmail(username, email string) (*MessageVO, error) {
+	response, err := r.client.SendPasswordRecoveryEmail(&SendPasswordRecoveryEmail{
+		UserType:     int32(r.userType),
+		UserName:     username,
+		EmailAddress: email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Return_, nil
+}
+
+// Policy returns the password policy of the user type, from the cache the
+// recovery was created with.
+func (r *AccountRecovery) Policy() (*PasswordPolicy, error) {
+	return r.policies.Policy(r.client, r.userType)
+}
+
+// PasswordReset is the outcome of ResetPassword.
+type PasswordReset struct {
+	Successful bool
+	// MinPasswordLength is the shortest password the server accepts.
+	MinPasswordLength int32
+	Messages          []*MessageVO
+}
+
+// ResetPassword checks newPassword against the user type's policy and, if
+// it meets it, sets it as the password of username using the token
+// mailed by SendResetEmail. When the server does not reset the password,
+// the reset is returned along with an error built from its messages.
+func (r *AccountRecovery) ResetPassword(username, token, newPassword string) (*PasswordReset, error) {
+	policy, err := r.Policy()
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Validate(newPassword); err != nil {
+		return nil, err
+	}
+	response, err := r.client.RecoverPassword(&RecoverPassword{
+		UserType:      int32(r.userType),
+		UserName:      username,
+		RecoveryToken: token,
+		NewPassword:   newPassword,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Return_ == nil {
+		return nil, errors.New("error: no password reset result returned")
+	}
+	reset := &PasswordReset{
+		Successful:        response.Return_.Successful,
+		MinPasswordLength: response.Return_.MinPasswordLength,
+	}
+	if response.Return_.BaseResultsVO != nil {
+		reset.Messages = response.Return_.BaseResultsVO.MessageVOs
+	}
+	switch {
+	case reset.Successful:
+		return reset, nil
+	case len(reset.Messages) > 0:
+		return reset, fmt.Errorf("error: %s - %s", reset.Messages[0].Title, reset.Messages[0].Description)
+	}
+	return reset, errors.New("error: password was not reset")
+}
@@ -0,0 +1,147 @@
+package gopowerschool
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const faultResponse = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><soapenv:Fault>` +
+	`<faultcode>soapenv:Server</faultcode><faultstring>mail server unavailable</faultstring></soapenv:Fault></soapenv:Body></soapenv:Envelope>`
+
+// recoveryServer answers a recovery operation with reply, after checking its
+// request body is want. Password rules require eight characters.
+func recoveryServer(t *testing.T, operation, want string, reply *string) *PublicPortalServiceJSONPortType {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		switch action := r.Header.Get("SOAPAction"); action {
+		case "urn:getCredentialComplexityRules":
+			io.WriteString(w, soapResponse("getCredentialComplexityRules", "<requiredCharacterCount>8</requiredCharacterCount>"))
+		case "urn:" + operation:
+			if string(body) != want {
+				t.Errorf("%s request\n%s\nwant\n%s", operation, body, want)
+			}
+			io.WriteString(w, *reply)
+		default:
+			t.Errorf("unexpected call %s", action)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestAccountRecoverySendUsername(t *testing.T) {
+	want := `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+		`<recoverUsername xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><emailAddress>parent@example.com</emailAddress></recoverUsername>` +
+		`</Body></Envelope>`
+	reply := soapResponse("recoverUsername", "<title>Email sent</title><description>Check your inbox.</description>")
+	recovery := recoveryServer(t, "recoverUsername", want, &reply).AccountRecovery(UserTypeGuardian, &PasswordPolicies{})
+
+	message, err := recovery.SendUsername("parent@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Title != "Email sent" || message.Description != "Check your inbox." {
+		t.Errorf("message = %+v", message)
+	}
+
+	reply = faultResponse
+	var fault *SOAPFault
+	if _, err := recovery.SendUsername("parent@example.com"); !errors.As(err, &fault) || fault.String != "mail server unavailable" {
+		t.Errorf("SendUsername on a fault = %v", err)
+	}
+}
+
+func TestAccountRecoverySendResetEmail(t *testing.T) {
+	want := `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+		`<sendPasswordRecoveryEmail xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userType>1</userType><userName>parent</userName>` +
+		`<emailAddress>parent@example.com</emailAddress></sendPasswordRecoveryEmail>` +
+		`</Body></Envelope>`
+	reply := soapResponse("sendPasswordRecoveryEmail", "<title>Email sent</title>")
+	recovery := recoveryServer(t, "sendPasswordRecoveryEmail", want, &reply).AccountRecovery(UserTypeGuardian, &PasswordPolicies{})
+
+	message, err := recovery.SendResetEmail("parent", "parent@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Title != "Email sent" {
+		t.Errorf("message = %+v", message)
+	}
+
+	reply = faultResponse
+	var fault *SOAPFault
+	if _, err := recovery.SendResetEmail("parent", "parent@example.com"); !errors.As(err, &fault) || fault.String != "mail server unavailable" {
+		t.Errorf("SendResetEmail on a fault = %v", err)
+	}
+}
+
+func TestAccountRecoveryResetPassword(t *testing.T) {
+	want := `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+		`<recoverPassword xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userType>1</userType><userName>parent</userName>` +
+		`<recoveryToken>token</recoveryToken><newPassword>correct horse</newPassword></recoverPassword>` +
+		`</Body></Envelope>`
+	tests := []struct {
+		name    string
+		reply   string
+		success bool
+		err     string
+	}{
+		{
+			name:    "reset",
+			reply:   "<successful>true</successful><minPasswordLength>8</minPasswordLength>",
+			success: true,
+		},
+		{
+			name:  "rejected with a message",
+			reply: "<messagesVO><title>Invalid token</title><description>The recovery token has expired.</description></messagesVO><minPasswordLength>8</minPasswordLength>",
+			err:   "error: Invalid token - The recovery token has expired.",
+		},
+		{
+			name:  "rejected without a message",
+			reply: "<successful>false</successful>",
+			err:   "error: password was not reset",
+		},
+		{
+			name:  "fault",
+			reply: faultResponse,
+			err:   "mail server unavailable",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reply := test.reply
+			if reply != faultResponse {
+				reply = soapResponse("recoverPassword", reply)
+			}
+			recovery := recoveryServer(t, "recoverPassword", want, &reply).AccountRecovery(UserTypeGuardian, &PasswordPolicies{})
+
+			reset, err := recovery.ResetPassword("parent", "token", "correct horse")
+			if test.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+			} else if err == nil || err.Error() != test.err {
+				t.Fatalf("ResetPassword() error = %v, want %s", err, test.err)
+			}
+			if test.success && (!reset.Successful || reset.MinPasswordLength != 8) {
+				t.Errorf("reset = %+v", reset)
+			}
+		})
+	}
+}
+
+// A password the policy rejects is not sent to the server.
+func TestAccountRecoveryResetPasswordChecksPolicy(t *testing.T) {
+	reply := soapResponse("recoverPassword", "<successful>true</successful>")
+	recovery := recoveryServer(t, "getCredentialComplexityRules", "", &reply).AccountRecovery(UserTypeGuardian, &PasswordPolicies{})
+
+	var violations PasswordViolations
+	if _, err := recovery.ResetPassword("parent", "token", "short"); !errors.As(err, &violations) || violations[0].Rule != PasswordRuleLength {
+		t.Errorf("ResetPassword() error = %v, want a length violation", err)
+	}
+}
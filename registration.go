@@ -0,0 +1,253 @@
+package gopowerschool
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Registration is a student's course requests: the request groups open to
+// them, the courses requested in each, and the credit rules that apply.
+// Load one with LoadRegistration, change the requests with Add and Remove,
+// and send them with Submit.
+type Registration struct {
+	Groups []*CourseRequestGroupVO
+	Rules  *CourseRequestRulesVO
+
+	client    *PublicPortalServiceJSONPortType
+	session   *UserSessionVO
+	studentID int64
+}
+
+// LoadRegistration fetches the course requests of a student.
+func (client *PublicPortalServiceJSONPortType) LoadRegistration(session *UserSessionVO, studentID int64) (*Registration, error) {
+	results, err := client.courseRequests(session, studentID)
+	if err != nil {
+		return nil, err
+	}
+	return &Registration{
+		Groups:    results.CourseRequestGroupsVOs,
+		Rules:     results.CourseRequestRulesVO,
+		client:    client,
+		session:   session,
+		studentID: studentID,
+	}, nil
+}
+
+func (client *PublicPortalServiceJSONPortType) courseRequests(session *UserSessionVO, studentID int64) (*ResultsVO, error) {
+	response, err := client.GetAllCourseRequests(&GetAllCourseRequests{UserSessionVO: session, StudentId: studentID})
+	if err != nil {
+		return nil, err
+	}
+	if response.Return_ == nil {
+		return nil, fmt.Errorf("error: no course requests returned for student %d", studentID)
+	}
+	for _, message := range response.Return_.MessageVOs {
+		if isErrorMessage(message) {
+			return nil, fmt.Errorf("error: %s - %s", message.Title, message.Description)
+		}
+	}
+	return response.Return_, nil
+}
+
+// Group returns the request group with an ID, or nil.
+func (r *Registration) Group(groupID int64) *CourseRequestGroupVO {
+	for _, group := range r.Groups {
+		if group.Id == groupID {
+			return group
+		}
+	}
+	return nil
+}
+
+// Add requests a course of a group. The course must be one the group
+// offers, unless the group lists none.
+func (r *Registration) Add(groupID int64, courseNumber string) error {
+	group := r.Group(groupID)
+	if group == nil {
+		return fmt.Errorf("no course request group %d", groupID)
+	}
+	if requestIndex(group.Requests, courseNumber) >= 0 {
+		return nil
+	}
+	course := &CourseRequestVO{CourseNumber: courseNumber}
+	if len(group.Courses) > 0 {
+		i := requestIndex(group.Courses, courseNumber)
+		if i < 0 {
+			return fmt.Errorf("group %q does not offer course %s", group.Name, courseNumber)
+		}
+		offered := *group.Courses[i]
+		offered.XMLName = xml.Name{}
+		course = &offered
+	}
+	group.Requests = append(group.Requests, course)
+	return nil
+}
+
+// Remove drops the request for a course of a group.
+func (r *Registration) Remove(groupID int64, courseNumber string) error {
+	group := r.Group(groupID)
+	if group == nil {
+		return fmt.Errorf("no course request group %d", groupID)
+	}
+	i := requestIndex(group.Requests, courseNumber)
+	if i < 0 {
+		return fmt.Errorf("course %s is not requested in group %q", courseNumber, group.Name)
+	}
+	group.Requests = append(group.Requests[:i:i], group.Requests[i+1:]...)
+	return nil
+}
+
+func requestIndex(requests []*CourseRequestVO, courseNumber string) int {
+	for i, request := range requests {
+		if request != nil && request.CourseNumber == courseNumber {
+			return i
+		}
+	}
+	return -1
+}
+
+// Credits returns the credit hours of every requested course.
+func (r *Registration) Credits() float64 {
+	return requestedCredits(r.Groups)
+}
+
+func requestedCredits(groups []*CourseRequestGroupVO) float64 {
+	var credits float64
+	for _, group := range groups {
+		for _, request := range group.Requests {
+			if request != nil {
+				credits += float64(request.CreditHours)
+			}
+		}
+	}
+	return credits
+}
+
+// RegistrationError is a problem with the requests of a group, or with the
+// registration as a whole when GroupID is 0. Code is the server's MessageVO
+// code, or 0 for problems found before submitting.
+type RegistrationError struct {
+	GroupID int64
+	Group   string
+	Code    int32
+	Message string
+}
+
+func (e *RegistrationError) Error() string {
+	if e.Group != "" {
+		return fmt.Sprintf("%s: %s", e.Group, e.Message)
+	}
+	return e.Message
+}
+
+// RegistrationErrors lists every problem with a registration.
+type RegistrationErrors []*RegistrationError
+
+func (errs RegistrationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the requests against each group's MinCourseCount and
+// MaxCourseCount and the MinCredits and MaxCredits rules. It returns nil
+// or RegistrationErrors.
+func (r *Registration) Validate() error {
+	var errs RegistrationErrors
+	for _, group := range r.Groups {
+		count := float32(len(group.Requests))
+		switch {
+		case group.MinCourseCount > 0 && count < group.MinCourseCount:
+			errs = append(errs, &RegistrationError{GroupID: group.Id, Group: group.Name,
+				Message: fmt.Sprintf("select at least %g courses, not %g", group.MinCourseCount, count)})
+		case group.MaxCourseCount > 0 && count > group.MaxCourseCount:
+			errs = append(errs, &RegistrationError{GroupID: group.Id, Group: group.Name,
+				Message: fmt.Sprintf("select at most %g courses, not %g", group.MaxCourseCount, count)})
+		}
+	}
+	if rules := r.Rules; rules != nil {
+		credits := r.Credits()
+		switch {
+		case rules.MinCredits > 0 && credits < rules.MinCredits:
+			errs = append(errs, &RegistrationError{Message: fmt.Sprintf("request at least %g credits, not %g", rules.MinCredits, credits)})
+		case rules.MaxCredits > 0 && credits > rules.MaxCredits:
+			errs = append(errs, &RegistrationError{Message: fmt.Sprintf("request at most %g credits, not %g", rules.MaxCredits, credits)})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Submit validates the requests and stores them. Problems found locally
+// or reported by the server are returned as RegistrationErrors; a server
+// message is attributed to a group when its ID is the group's.
+func (r *Registration) Submit() error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return r.store(r.submission())
+}
+
+func (r *Registration) store(groups []*CourseRequestGroupVO) error {
+	response, err := r.client.StoreCourseRequests(&StoreCourseRequests{
+		UserSessionVO:       r.session,
+		StudentId:           r.studentID,
+		CourseRequestGroups: groups,
+	})
+	if err != nil {
+		return err
+	}
+	if response.Return_ != nil {
+		if errs := r.messageErrors(response.Return_.MessageVOs); len(errs) > 0 {
+			return errs
+		}
+	}
+	return nil
+}
+
+// submission copies the groups for StoreCourseRequests. The element names
+// recorded when they were decoded are cleared, as they would otherwise
+// replace the names the request expects.
+func (r *Registration) submission() []*CourseRequestGroupVO {
+	groups := make([]*CourseRequestGroupVO, len(r.Groups))
+	for i, group := range r.Groups {
+		submitted := *group
+		submitted.XMLName = xml.Name{}
+		submitted.Requests = make([]*CourseRequestVO, len(group.Requests))
+		for j, request := range group.Requests {
+			copied := *request
+			copied.XMLName = xml.Name{}
+			submitted.Requests[j] = &copied
+		}
+		groups[i] = &submitted
+	}
+	return groups
+}
+
+// messageErrors returns the error messages of a StoreCourseRequests reply,
+// skipping informational ones.
+func (r *Registration) messageErrors(messages []*MessageVO) RegistrationErrors {
+	var errs RegistrationErrors
+	for _, message := range messages {
+		if !isErrorMessage(message) {
+			continue
+		}
+		err := &RegistrationError{Code: message.MsgCode, Message: message.Description}
+		if err.Message == "" {
+			err.Message = message.Title
+		}
+		if id, parseErr := strconv.ParseInt(message.Id, 10, 64); parseErr == nil {
+			if group := r.Group(id); group != nil {
+				err.GroupID, err.Group = group.Id, group.Name
+			}
+		}
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -0,0 +1,123 @@
+package gopowerschool
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RegistrationPreview is what submitting a registration would change,
+// compared with the requests currently stored on the server. Nothing is
+// stored until Confirm is called.
+type RegistrationPreview struct {
+	// Changes lists the groups whose requests would change.
+	Changes       []GroupChange
+	CreditsBefore float64
+	CreditsAfter  float64
+	// Violations is every course count and credit rule the proposed
+	// requests break; a preview with violations cannot be confirmed.
+	Violations RegistrationErrors
+
+	registration *Registration
+	groups       []*CourseRequestGroupVO
+}
+
+// GroupChange is the courses added to and removed from a request group.
+type GroupChange struct {
+	GroupID int64
+	Group   string
+	Added   []*CourseRequestVO
+	Removed []*CourseRequestVO
+}
+
+// Preview fetches the requests currently stored for the student and
+// compares them with the registration's, without storing anything.
+func (r *Registration) Preview() (*RegistrationPreview, error) {
+	current, err := r.client.courseRequests(r.session, r.studentID)
+	if err != nil {
+		return nil, err
+	}
+	preview := &RegistrationPreview{
+		CreditsBefore: requestedCredits(current.CourseRequestGroupsVOs),
+		CreditsAfter:  r.Credits(),
+		registration:  r,
+		groups:        r.submission(),
+	}
+	if err := r.Validate(); err != nil {
+		preview.Violations = err.(RegistrationErrors)
+	}
+
+	stored := map[int64]*CourseRequestGroupVO{}
+	for _, group := range current.CourseRequestGroupsVOs {
+		stored[group.Id] = group
+	}
+	for _, group := range r.Groups {
+		var before []*CourseRequestVO
+		if storedGroup := stored[group.Id]; storedGroup != nil {
+			before = storedGroup.Requests
+			delete(stored, group.Id)
+		}
+		preview.add(group, before, group.Requests)
+	}
+	for _, group := range current.CourseRequestGroupsVOs {
+		if stored[group.Id] != nil {
+			preview.add(group, group.Requests, nil)
+		}
+	}
+	return preview, nil
+}
+
+func (p *RegistrationPreview) add(group *CourseRequestGroupVO, before, after []*CourseRequestVO) {
+	change := GroupChange{GroupID: group.Id, Group: group.Name}
+	for _, request := range after {
+		if request != nil && requestIndex(before, request.CourseNumber) < 0 {
+			change.Added = append(change.Added, request)
+		}
+	}
+	for _, request := range before {
+		if request != nil && requestIndex(after, request.CourseNumber) < 0 {
+			change.Removed = append(change.Removed, request)
+		}
+	}
+	if len(change.Added) > 0 || len(change.Removed) > 0 {
+		p.Changes = append(p.Changes, change)
+	}
+}
+
+// Changed reports whether confirming would change any request.
+func (p *RegistrationPreview) Changed() bool {
+	return len(p.Changes) > 0
+}
+
+// Confirm stores the requests as they were when the preview was made,
+// even if the registration has been changed since. It returns the
+// preview's Violations without storing anything if there are any.
+func (p *RegistrationPreview) Confirm() error {
+	if len(p.Violations) > 0 {
+		return p.Violations
+	}
+	return p.registration.store(p.groups)
+}
+
+// String formats the preview as a diff, e.g.
+//
+//	Electives
+//	  + A1 Art
+//	  - M1 Music
+//	credits: 3 -> 3 (+0)
+func (p *RegistrationPreview) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		fmt.Fprintln(&b, change.Group)
+		for _, request := range change.Added {
+			fmt.Fprintf(&b, "  + %s\n", strings.TrimSpace(request.CourseNumber+" "+request.CourseName))
+		}
+		for _, request := range change.Removed {
+			fmt.Fprintf(&b, "  - %s\n", strings.TrimSpace(request.CourseNumber+" "+request.CourseName))
+		}
+	}
+	fmt.Fprintf(&b, "credits: %g -> %g (%+g)\n", p.CreditsBefore, p.CreditsAfter, p.CreditsAfter-p.CreditsBefore)
+	for _, violation := range p.Violations {
+		fmt.Fprintf(&b, "! %s\n", violation)
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package gopowerschool
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+// storedRequests decodes a storeCourseRequests request into the course
+// numbers requested in each group.
+func storedRequests(t *testing.T, body string) map[int64][]string {
+	var envelope struct {
+		Body struct {
+			Store StoreCourseRequests
+		}
+	}
+	if err := xml.Unmarshal([]byte(body), &envelope); err != nil {
+		t.Fatal(err)
+	}
+	requests := map[int64][]string{}
+	for _, group := range envelope.Body.Store.CourseRequestGroups {
+		numbers := []string{}
+		for _, request := range group.Requests {
+			numbers = append(numbers, request.CourseNumber)
+		}
+		requests[group.Id] = numbers
+	}
+	return requests
+}
+
+// Confirm stores the selection that was previewed, not the registration as
+// it was changed afterwards.
+func TestRegistrationPreviewConfirm(t *testing.T) {
+	var stores []string
+	client := registrationServer(t, "", func(body string) { stores = append(stores, body) })
+	registration, err := client.LoadRegistration(&UserSessionVO{}, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Remove(1, "M1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Add(1, "M2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Add(2, "E1"); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := registration.Preview()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Math\n  + M2\n  - M1\nElectives\n  + E1\ncredits: 1 -> 1 (+0)\n"
+	if got := preview.String(); got != want {
+		t.Errorf("preview\n%s\nwant\n%s", got, want)
+	}
+	if len(stores) != 0 {
+		t.Fatal("Preview stored requests")
+	}
+
+	for _, change := range []func() error{
+		func() error { return registration.Remove(1, "M2") },
+		func() error { return registration.Add(1, "M1") },
+		func() error { return registration.Remove(2, "E1") },
+		func() error { return registration.Add(2, "E2") },
+	} {
+		if err := change(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := preview.Confirm(); err != nil {
+		t.Fatal(err)
+	}
+	if len(stores) != 1 {
+		t.Fatalf("stored %d times, want once", len(stores))
+	}
+	got := storedRequests(t, stores[0])
+	if len(got) != 2 || strings.Join(got[1], " ") != "M2" || strings.Join(got[2], " ") != "E1" {
+		t.Errorf("stored requests %v, want the previewed map[1:[M2] 2:[E1]]", got)
+	}
+}
+
+func TestRegistrationPreviewViolations(t *testing.T) {
+	client := registrationServer(t, "", func(string) { t.Error("a preview with violations was stored") })
+	registration, err := client.LoadRegistration(&UserSessionVO{}, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Remove(1, "M1"); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := registration.Preview()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.Violations) != 2 || !preview.Changed() {
+		t.Fatalf("preview = %s", preview)
+	}
+	if err := preview.Confirm(); err == nil {
+		t.Error("Confirm stored a preview with violations")
+	}
+}
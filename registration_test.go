@@ -0,0 +1,129 @@
+package gopowerschool
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const courseRequestGroups = `<courseRequestGroupsVOs><id>1</id><name>Math</name><minCourseCount>1</minCourseCount><maxCourseCount>1</maxCourseCount>` +
+	`<courses><courseNumber>M1</courseNumber><creditHours>1</creditHours></courses>` +
+	`<courses><courseNumber>M2</courseNumber><creditHours>1</creditHours></courses>` +
+	`<requests><courseNumber>M1</courseNumber><creditHours>1</creditHours></requests></courseRequestGroupsVOs>` +
+	`<courseRequestGroupsVOs><id>2</id><name>Electives</name><maxCourseCount>3</maxCourseCount></courseRequestGroupsVOs>` +
+	`<courseRequestRulesVO><minCredits>1</minCredits><maxCredits>1.5</maxCredits></courseRequestRulesVO>` +
+	`<messageVOs><title>Registration closes Friday</title></messageVOs>`
+
+// registrationServer answers getAllCourseRequests with courseRequestGroups
+// and storeCourseRequests with stored, passing the request bodies of
+// stores to store.
+func registrationServer(t *testing.T, stored string, store func(body string)) *PublicPortalServiceJSONPortType {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		switch action := r.Header.Get("SOAPAction"); action {
+		case "urn:getAllCourseRequests":
+			io.WriteString(w, soapResponse("getAllCourseRequests", courseRequestGroups))
+		case "urn:storeCourseRequests":
+			store(string(body))
+			io.WriteString(w, soapResponse("storeCourseRequests", stored))
+		default:
+			t.Errorf("unexpected call %s", action)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRegistration(t *testing.T) {
+	var stores []string
+	client := registrationServer(t, "<messageVOs><title>Requests saved</title></messageVOs>", func(body string) {
+		stores = append(stores, body)
+	})
+
+	registration, err := client.LoadRegistration(&UserSessionVO{}, 7)
+	if err != nil {
+		t.Fatalf("LoadRegistration with an informational message: %v", err)
+	}
+	if err := registration.Add(1, "M3"); err == nil {
+		t.Error("Add accepted a course the group does not offer")
+	}
+	if err := registration.Add(3, "M1"); err == nil {
+		t.Error("Add accepted an unknown group")
+	}
+	if err := registration.Remove(2, "E1"); err == nil {
+		t.Error("Remove accepted a course that is not requested")
+	}
+
+	// Two math courses break the group's course count, and the credit rule.
+	if err := registration.Add(1, "M2"); err != nil {
+		t.Fatal(err)
+	}
+	var errs RegistrationErrors
+	if err := registration.Submit(); !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Submit() error = %v, want two violations", err)
+	}
+	if errs[0].GroupID != 1 || errs[1].GroupID != 0 {
+		t.Errorf("violations = %v", errs)
+	}
+	if len(stores) != 0 {
+		t.Fatal("Submit stored requests that break the rules")
+	}
+
+	if err := registration.Remove(1, "M1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Add(2, "E1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registration.Submit(); err != nil {
+		t.Fatalf("Submit() with an informational reply: %v", err)
+	}
+	if len(stores) != 1 {
+		t.Fatalf("stored %d times, want once", len(stores))
+	}
+	for _, want := range []string{
+		"<courseRequestGroups><courses><courseNumber>M1</courseNumber>",
+		"<name>Math</name><requests><courseNumber>M2</courseNumber><creditHours>1</creditHours></requests></courseRequestGroups>",
+		"<id>2</id>",
+		"<requests><courseNumber>E1</courseNumber></requests>",
+	} {
+		if !strings.Contains(stores[0], want) {
+			t.Errorf("stored request is missing %s:\n%s", want, stores[0])
+		}
+	}
+}
+
+func TestRegistrationSubmitErrors(t *testing.T) {
+	stored := `<messageVOs><title>Requests saved</title></messageVOs>` +
+		`<messageVOs><msgCode>7</msgCode><id>1</id><title>Math</title><description>M2 is full.</description></messageVOs>` +
+		`<messageVOs><msgCode>8</msgCode><id>Math</id><title>Math and Electives</title><description>Ask your counselor.</description></messageVOs>`
+	client := registrationServer(t, stored, func(string) {})
+	registration, err := client.LoadRegistration(&UserSessionVO{}, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var errs RegistrationErrors
+	if err := registration.Submit(); !errors.As(err, &errs) {
+		t.Fatalf("Submit() error = %v, want RegistrationErrors", err)
+	}
+	want := RegistrationErrors{
+		{GroupID: 1, Group: "Math", Code: 7, Message: "M2 is full."},
+		// A group named in the title is not enough to attribute a message.
+		{Code: 8, Message: "Ask your counselor."},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("errors = %v, want %v", errs, want)
+	}
+	for i := range want {
+		if *errs[i] != *want[i] {
+			t.Errorf("error %d = %+v, want %+v", i, errs[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package gopowerschool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrEmptyResponse is returned when the server answers a call with no body.
+var ErrEmptyResponse = errors.New("empty response")
+
+// HTTPError is returned when the server answers with an error status and no
+// SOAP envelope.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	// DigestStale is set when a 401 challenge marks the nonce as stale.
+	DigestStale bool
+}
+
+func newHTTPError(res *http.Response) *HTTPError {
+	stale := false
+	for _, challenge := range res.Header.Values("Www-Authenticate") {
+		if strings.Contains(strings.ToLower(challenge), "stale=true") {
+			stale = true
+		}
+	}
+	return &HTTPError{StatusCode: res.StatusCode, Status: res.Status, DigestStale: stale}
+}
+
+func (e *HTTPError) Error() string {
+	if e.DigestStale {
+		return fmt.Sprintf("http error: %s (stale digest nonce)", e.Status)
+	}
+	return fmt.Sprintf("http error: %s", e.Status)
+}
+
+// DefaultNonIdempotentActions are the SOAP actions that change server state
+// and are not retried unless RetryPolicy.Actions says otherwise.
+var DefaultNonIdempotentActions = map[string]bool{
+	"urn:storeCourseRequests":        true,
+	"urn:storeNotificationSettings":  true,
+	"urn:linkDeviceTokenToUser":      true,
+	"urn:logoutAndDelinkDeviceToken": true,
+	"urn:sendPasswordRecoveryEmail":  true,
+	"urn:recoverPassword":            true,
+	"urn:recoverUsername":            true,
+}
+
+// RetryPolicy controls how SOAPClient retries failed calls.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt. Each further
+	// wait is Multiplier times longer, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter randomly shortens each wait by up to this fraction (0 to 1).
+	Jitter float64
+	// Deadline bounds the whole call, including waits. Zero means no limit.
+	Deadline time.Duration
+	// Retryable reports whether an error is worth retrying. Nil means
+	// IsRetryable.
+	Retryable func(error) bool
+	// Actions reports whether a SOAP action may be retried. Nil means every
+	// action not in DefaultNonIdempotentActions.
+	Actions func(soapAction string) bool
+}
+
+// DefaultRetryPolicy makes up to four attempts over at most a minute.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     8 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.5,
+	Deadline:       time.Minute,
+}
+
+// WithRetryPolicy retries failed calls according to policy. Calls are not
+// retried by default.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) error {
+		if policy.MaxAttempts < 1 {
+			return errors.New("retry policy needs at least one attempt")
+		}
+		c.retry = &policy
+		return nil
+	}
+}
+
+// IsRetryable reports whether err is a transient failure: a timeout, a reset
+// or dropped connection, an empty response, a 5xx status or a stale digest
+// challenge.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500 || httpErr.DigestStale
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, ErrEmptyResponse) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func (p *RetryPolicy) allows(soapAction string) bool {
+	if p.Actions != nil {
+		return p.Actions(soapAction)
+	}
+	return !DefaultNonIdempotentActions[soapAction]
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff returns the wait after the given failed attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait -= wait * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(wait)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
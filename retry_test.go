@@ -0,0 +1,39 @@
+package gopowerschool
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// A retry after a body cut off mid-stream must not add to what the failed
+// attempt decoded.
+func TestRetryDecodesIntoFreshResponse(t *testing.T) {
+	full := soapResponse("getStudentData", "<studentDataVOs></studentDataVOs><studentDataVOs></studentDataVOs>")
+	calls := 0
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		calls++
+		if calls == 1 {
+			io.WriteString(w, full[:len(full)-len("</ns:return></ns:getStudentDataResponse></soapenv:Body></soapenv:Envelope>")])
+			return
+		}
+		io.WriteString(w, full)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, Retryable: func(error) bool { return true }}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := client.GetStudentData(&GetStudentData{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("server answered %d calls, want 2", calls)
+	}
+	if n := len(response.Return_.StudentDataVOs); n != 2 {
+		t.Errorf("decoded %d students, want 2", n)
+	}
+}
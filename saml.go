@@ -0,0 +1,311 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// SAML bindings a SAMLRequest can arrive by.
+const (
+	SAMLBindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	SAMLBindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// ssoTicketParam is the query parameter the server's final redirect
+// carries the service ticket in.
+const ssoTicketParam = "serviceTicket"
+
+// SAMLRequest is the authentication request the PowerSchool server sends
+// to the district's identity provider.
+type SAMLRequest struct {
+	// URL is the identity provider's single sign-on URL. For the redirect
+	// binding it is the whole URL the server redirected to, query and
+	// signature included, ready to open in a browser.
+	URL     string
+	Binding string
+	// SAMLRequest is the encoded AuthnRequest as sent: base64, and also
+	// deflated for the redirect binding.
+	SAMLRequest string
+	RelayState  string
+	// SigAlg and Signature sign a redirect binding request, if the server
+	// signs them.
+	SigAlg    string
+	Signature string
+}
+
+// SAMLAuthnRequest is the part of a decoded AuthnRequest an identity
+// provider needs to answer it.
+type SAMLAuthnRequest struct {
+	ID                          string `xml:"ID,attr"`
+	Destination                 string `xml:"Destination,attr"`
+	AssertionConsumerServiceURL string `xml:"AssertionConsumerServiceURL,attr"`
+	Issuer                      string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+}
+
+// AuthnRequest decodes the request.
+func (r *SAMLRequest) AuthnRequest() (*SAMLAuthnRequest, error) {
+	data, err := base64.StdEncoding.DecodeString(r.SAMLRequest)
+	if err != nil {
+		return nil, fmt.Errorf("saml: %w", err)
+	}
+	if r.Binding == SAMLBindingRedirect {
+		if data, err = io.ReadAll(flate.NewReader(bytes.NewReader(data))); err != nil {
+			return nil, fmt.Errorf("saml: %w", err)
+		}
+	}
+	request := new(SAMLAuthnRequest)
+	if err := xml.Unmarshal(data, request); err != nil {
+		return nil, fmt.Errorf("saml: %w", err)
+	}
+	return request, nil
+}
+
+// SAMLResponse is an identity provider's answer to a SAMLRequest.
+type SAMLResponse struct {
+	// URL is the assertion consumer service the response is posted to. If
+	// it is empty, the AssertionConsumerServiceURL of the request is used.
+	URL string
+	// SAMLResponse is the base64 encoded Response carrying the assertion.
+	SAMLResponse string
+	RelayState   string
+}
+
+// IdentityProvider signs a user on at the district's identity provider,
+// e.g. by driving its login form or by opening a browser.
+type IdentityProvider interface {
+	SignOn(ctx context.Context, request *SAMLRequest) (*SAMLResponse, error)
+}
+
+// IdentityProviderFunc adapts a function to IdentityProvider.
+type IdentityProviderFunc func(ctx context.Context, request *SAMLRequest) (*SAMLResponse, error)
+
+func (f IdentityProviderFunc) SignOn(ctx context.Context, request *SAMLRequest) (*SAMLResponse, error) {
+	return f(ctx, request)
+}
+
+// LoginWithSSO logs in to an account of userType through the district's
+// SAML identity provider. It looks up the SAML endpoint the server
+// advertises for userType with Discover, starts the SP-initiated flow
+// there, has idp answer the AuthnRequest, posts the assertion back and
+// returns a session with the service ticket the server redirects to. The
+// redirect carries no more than the ticket, so the session's Locale and
+// StudentIDs are left empty.
+func (client *PublicPortalServiceJSONPortType) LoginWithSSO(ctx context.Context, userType UserType, idp IdentityProvider) (*Session, error) {
+	endpoint, err := client.Discover()
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.ServerInfo == nil {
+		return nil, errors.New("saml: server did not describe its SSO endpoints")
+	}
+	path := endpoint.ServerInfo.SAMLEndpoint(userType)
+	if path == "" {
+		return nil, fmt.Errorf("saml: server has no SSO endpoint for %s accounts", userType)
+	}
+	base, err := url.Parse(endpoint.URL)
+	if err != nil {
+		return nil, err
+	}
+	start, err := base.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("saml: endpoint %q: %w", path, err)
+	}
+
+	flow := newSSOFlow(client)
+	request, err := flow.begin(ctx, start.String())
+	if err != nil {
+		return nil, err
+	}
+	response, err := idp.SignOn(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("saml: identity provider: %w", err)
+	}
+	if response.URL == "" {
+		authn, err := request.AuthnRequest()
+		if err != nil {
+			return nil, err
+		}
+		if response.URL = authn.AssertionConsumerServiceURL; response.URL == "" {
+			return nil, errors.New("saml: no assertion consumer service to post the response to")
+		}
+	}
+	ticket, err := flow.finish(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{
+		UserSessionVO: &UserSessionVO{ServiceTicket: ticket, UserType: int32(userType), ServerInfo: endpoint.ServerInfo},
+		UserType:      userType,
+	}, nil
+}
+
+// ssoFlow is the browser side of the SP-initiated flow: one cookie jar
+// across both legs, stopping at the redirects it is looking for.
+type ssoFlow struct {
+	http *http.Client
+}
+
+func newSSOFlow(client *PublicPortalServiceJSONPortType) *ssoFlow {
+	transport := http.DefaultTransport
+	if t, ok := client.client.(httpTransporter); ok {
+		transport = t.httpTransport()
+	}
+	jar, _ := cookiejar.New(nil)
+	return &ssoFlow{http: &http.Client{Transport: transport, Jar: jar}}
+}
+
+// begin requests the server's SAML endpoint and returns the AuthnRequest
+// it sends, by redirect or by an auto-submitting form.
+func (f *ssoFlow) begin(ctx context.Context, endpoint string) (*SAMLRequest, error) {
+	var redirected *url.URL
+	f.http.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if req.URL.Query().Get("SAMLRequest") != "" {
+			redirected = req.URL
+			return http.ErrUseLastResponse
+		}
+		return checkRedirects(via)
+	}
+	page, body, err := f.do(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if redirected != nil {
+		query := redirected.Query()
+		return &SAMLRequest{
+			URL:         redirected.String(),
+			Binding:     SAMLBindingRedirect,
+			SAMLRequest: query.Get("SAMLRequest"),
+			RelayState:  query.Get("RelayState"),
+			SigAlg:      query.Get("SigAlg"),
+			Signature:   query.Get("Signature"),
+		}, nil
+	}
+	action, fields := parseForm(body)
+	if fields["SAMLRequest"] == "" {
+		return nil, fmt.Errorf("saml: %s did not start a SAML login", endpoint)
+	}
+	target, err := page.Parse(action)
+	if err != nil {
+		return nil, fmt.Errorf("saml: form action %q: %w", action, err)
+	}
+	return &SAMLRequest{URL: target.String(), Binding: SAMLBindingPOST, SAMLRequest: fields["SAMLRequest"], RelayState: fields["RelayState"]}, nil
+}
+
+// finish posts the identity provider's response to the server and returns
+// the service ticket from the redirect that ends the flow.
+func (f *ssoFlow) finish(ctx context.Context, response *SAMLResponse) (string, error) {
+	var ticket string
+	f.http.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if ticket = req.URL.Query().Get(ssoTicketParam); ticket != "" {
+			return http.ErrUseLastResponse
+		}
+		return checkRedirects(via)
+	}
+	form := url.Values{"SAMLResponse": {response.SAMLResponse}}
+	if response.RelayState != "" {
+		form.Set("RelayState", response.RelayState)
+	}
+	page, _, err := f.do(ctx, http.MethodPost, response.URL, form)
+	if err != nil {
+		return "", err
+	}
+	if ticket == "" {
+		ticket = page.Query().Get(ssoTicketParam)
+	}
+	if ticket == "" {
+		return "", errors.New("saml: server did not return a service ticket")
+	}
+	return ticket, nil
+}
+
+// do makes a request, following redirects as CheckRedirect allows, and
+// returns the URL of the page it ended on along with its body.
+func (f *ssoFlow) do(ctx context.Context, method, target string, form url.Values) (*url.URL, []byte, error) {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	res, err := f.http.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 400 {
+		return nil, nil, fmt.Errorf("saml: %s %s: %s", method, target, res.Status)
+	}
+	page, err := io.ReadAll(res.Body)
+	return res.Request.URL, page, err
+}
+
+func checkRedirects(via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("saml: stopped after 10 redirects")
+	}
+	return nil
+}
+
+// rawText matches the elements whose content is not markup, which the XML
+// decoder cannot read even in its HTML mode.
+var rawText = regexp.MustCompile(`(?is)<script\b.*?</script\s*>|<style\b.*?</style\s*>`)
+
+// parseForm returns the action and fields of the first form in an HTML
+// page, as sent by the SAML POST binding. The page is tokenized with the
+// XML decoder's HTML mode, so attributes are matched by their whole name.
+func parseForm(page []byte) (string, map[string]string) {
+	decoder := xml.NewDecoder(bytes.NewReader(rawText.ReplaceAll(page, nil)))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+	fields := map[string]string{}
+	var action string
+	inForm := false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return action, fields
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch strings.ToLower(t.Name.Local) {
+			case "form":
+				inForm = true
+				action = htmlAttr(t, "action")
+			case "input":
+				if name := htmlAttr(t, "name"); inForm && name != "" {
+					fields[name] = htmlAttr(t, "value")
+				}
+			}
+		case xml.EndElement:
+			if inForm && strings.EqualFold(t.Name.Local, "form") {
+				return action, fields
+			}
+		}
+	}
+}
+
+func htmlAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Space == "" && strings.EqualFold(attr.Name.Local, name) {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,166 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const (
+	testSigAlg    = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	testSignature = "c2lnbmF0dXJl"
+)
+
+// samlServiceProvider stands in for a PowerSchool server with SAML
+// endpoints. Its service advertises /saml/start for students and
+// /saml/start?binding=post for teachers. /saml/start sends an AuthnRequest
+// by the binding named in its query and sets a cookie the assertion
+// consumer service at /saml/acs checks before redirecting with a service
+// ticket.
+func samlServiceProvider(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.Handle("/pearson-rest/", serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		jsonEncoded := strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+		switch action := r.Header.Get("SOAPAction"); {
+		case action == "urn:getCredentialComplexityRules" && jsonEncoded:
+			io.WriteString(w, `{"getCredentialComplexityRulesResponse": {"return": {"requiredCharacterCount": 8}}}`)
+		case action == "urn:getCredentialComplexityRules":
+			io.WriteString(w, soapResponse("getCredentialComplexityRules", "<requiredCharacterCount>8</requiredCharacterCount>"))
+		case action == "urn:logout" && jsonEncoded:
+			io.WriteString(w, `{"logoutResponse": {"return": {"userSessionVO": {"serverInfo": {"apiVersion": "23.4.0",`+
+				`"studentSAMLEndPoint": "/saml/start", "teacherSAMLEndPoint": "/saml/start?binding=post"}}}}}`)
+		case action == "urn:logout":
+			io.WriteString(w, soapResponse("logout", "<userSessionVO><serverInfo><apiVersion>23.4.0</apiVersion>"+
+				"<studentSAMLEndPoint>/saml/start</studentSAMLEndPoint>"+
+				"<teacherSAMLEndPoint>/saml/start?binding=post</teacherSAMLEndPoint></serverInfo></userSessionVO>"))
+		default:
+			t.Errorf("unexpected call %s", action)
+		}
+	}))
+	authn := fmt.Sprintf(`<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="id-1" Destination="%[1]s/idp/sso" AssertionConsumerServiceURL="%[1]s/saml/acs"><saml:Issuer>powerschool</saml:Issuer></samlp:AuthnRequest>`, server.URL)
+
+	mux.HandleFunc("/saml/start", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "sp", Value: "started", Path: "/"})
+		if r.URL.Query().Get("binding") == "post" {
+			fmt.Fprintf(w, `<html><body onload="document.forms[0].submit()"><form method="post" action="%s/idp/sso">`+
+				`<input type="hidden" name="SAMLRequest" value="%s"/><input type="hidden" name="RelayState" value="state"/></form></body></html>`,
+				server.URL, html.EscapeString(base64.StdEncoding.EncodeToString([]byte(authn))))
+			return
+		}
+		var deflated bytes.Buffer
+		writer, _ := flate.NewWriter(&deflated, flate.DefaultCompression)
+		writer.Write([]byte(authn))
+		writer.Close()
+		query := url.Values{
+			"SAMLRequest": {base64.StdEncoding.EncodeToString(deflated.Bytes())},
+			"RelayState":  {"state"},
+			"SigAlg":      {testSigAlg},
+			"Signature":   {testSignature},
+		}
+		http.Redirect(w, r, server.URL+"/idp/sso?"+query.Encode(), http.StatusFound)
+	})
+	mux.HandleFunc("/saml/acs", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("sp"); err != nil || cookie.Value != "started" {
+			t.Errorf("assertion posted without the cookie set at the start")
+		}
+		if r.PostFormValue("SAMLResponse") != "assertion" || r.PostFormValue("RelayState") != "state" {
+			t.Errorf("posted %v", r.PostForm)
+		}
+		http.Redirect(w, r, "/home?"+ssoTicketParam+"=ticket", http.StatusFound)
+	})
+	mux.HandleFunc("/home", func(w http.ResponseWriter, r *http.Request) {})
+	return server
+}
+
+func TestLoginWithSSO(t *testing.T) {
+	server := samlServiceProvider(t)
+	defer server.Close()
+
+	for _, test := range []struct {
+		userType UserType
+		binding  string
+	}{
+		{UserTypeStudent, SAMLBindingRedirect},
+		{UserTypeTeacher, SAMLBindingPOST},
+	} {
+		binding := test.binding
+		for _, encoding := range []Encoding{EncodingXML, EncodingJSON} {
+			name := fmt.Sprintf("binding %s, encoding %d", binding, encoding)
+			idp := IdentityProviderFunc(func(ctx context.Context, request *SAMLRequest) (*SAMLResponse, error) {
+				if request.Binding != binding {
+					t.Errorf("%s: request came by %s", name, request.Binding)
+				}
+				authn, err := request.AuthnRequest()
+				if err != nil {
+					return nil, err
+				}
+				if authn.AssertionConsumerServiceURL != server.URL+"/saml/acs" || authn.Issuer != "powerschool" {
+					t.Errorf("%s: decoded %+v", name, authn)
+				}
+				if binding == SAMLBindingRedirect {
+					target, err := url.Parse(request.URL)
+					if err != nil {
+						return nil, err
+					}
+					query := target.Query()
+					if query.Get("Signature") != testSignature || query.Get("SigAlg") != testSigAlg || query.Get("SAMLRequest") == "" {
+						t.Errorf("%s: redirect URL %s lost its signed query", name, request.URL)
+					}
+					if request.Signature != testSignature || request.SigAlg != testSigAlg {
+						t.Errorf("%s: signature %q, %q", name, request.SigAlg, request.Signature)
+					}
+				}
+				return &SAMLResponse{SAMLResponse: "assertion", RelayState: request.RelayState}, nil
+			})
+
+			recorder := &Recorder{}
+			client, err := NewClient(server.URL, WithEncoding(encoding), WithHTTPTransport(recorder))
+			if err != nil {
+				t.Fatal(err)
+			}
+			session, err := client.LoginWithSSO(context.Background(), test.userType, idp)
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+			if session.UserSessionVO.ServiceTicket != "ticket" || session.UserType != test.userType || session.UserSessionVO.ServerInfo.ApiVersion != "23.4.0" {
+				t.Errorf("%s: session %+v", name, session.UserSessionVO)
+			}
+			recorded := false
+			for _, interaction := range recorder.Cassette().Interactions {
+				recorded = recorded || strings.Contains(interaction.Request.URL, "/saml/acs")
+			}
+			if !recorded {
+				t.Errorf("%s: the flow did not use the client's transport", name)
+			}
+		}
+	}
+}
+
+func TestParseForm(t *testing.T) {
+	page := []byte(`<!DOCTYPE html><html><head><script>if (a < b) {}</script></head><body>
+<form method="post" action="https://idp.example.com/sso?a=1&amp;b=2">
+<input type="hidden" name="SAMLRequest" data-value="decoy" value="PHNhbWw+">
+<input type=hidden name=RelayState value='state'>
+<input type="submit">
+</form>
+<form action="/other"><input name="SAMLRequest" value="second"></form>
+</body></html>`)
+	action, fields := parseForm(page)
+	if action != "https://idp.example.com/sso?a=1&b=2" {
+		t.Errorf("action = %q", action)
+	}
+	if len(fields) != 2 || fields["SAMLRequest"] != "PHNhbWw+" || fields["RelayState"] != "state" {
+		t.Errorf("fields = %q", fields)
+	}
+}
@@ -0,0 +1,115 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"mime"
+	"strings"
+)
+
+// SchoolMap is the campus map of a school.
+type SchoolMap struct {
+	School *SchoolVO
+	// Data holds the map as served, e.g. a PDF or an image.
+	Data []byte
+	// ContentType is the school's MapMimeType, or the detected type of Data
+	// when the school does not give one.
+	ContentType string
+	// Image is the decoded map when Data is a JPEG, PNG or GIF image, and
+	// nil otherwise, e.g. for PDFs.
+	Image image.Image
+}
+
+// SchoolMap downloads the map of school. When cache is not nil, the map is
+// served from it as long as school.SchoolMapModifiedDate is unchanged; a
+// cached map that no longer decodes is evicted and downloaded again. It
+// returns ErrNoMedia if the school has no map.
+func (client *PublicPortalServiceJSONPortType) SchoolMap(session *UserSessionVO, school *SchoolVO, cache *MediaCache) (*SchoolMap, error) {
+	key := fmt.Sprintf("%s/map/%d", client.client.URL(), school.SchoolNumber)
+	if data, _, ok := cache.load(key, school.SchoolMapModifiedDate); ok {
+		if schoolMap, err := newSchoolMap(school, data); err == nil {
+			return schoolMap, nil
+		}
+		if err := cache.evict(key); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := client.GetSchoolMapBySchoolNumber(&GetSchoolMapBySchoolNumber{UserSessionVO: session, SchoolNumber: school.SchoolNumber})
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeMedia(response.Return_)
+	if err != nil {
+		return nil, err
+	}
+	schoolMap, err := newSchoolMap(school, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.store(key, school.SchoolMapModifiedDate, schoolMap.ContentType, data); err != nil {
+		return nil, err
+	}
+	return schoolMap, nil
+}
+
+// newSchoolMap returns the map of school held in data, decoding it if it
+// is an image.
+func newSchoolMap(school *SchoolVO, data []byte) (*SchoolMap, error) {
+	schoolMap := &SchoolMap{School: school, Data: data, ContentType: mapContentType(school.MapMimeType, data)}
+	switch mediaType(data) {
+	case "image/jpeg", "image/png", "image/gif":
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("map of school %d: %w", school.SchoolNumber, err)
+		}
+		schoolMap.Image = img
+	}
+	return schoolMap, nil
+}
+
+// SchoolMaps downloads the map of every school in data, including remote
+// schools. Schools without a map are left out. If some maps cannot be
+// downloaded, the others are returned along with the errors.
+func (client *PublicPortalServiceJSONPortType) SchoolMaps(session *UserSessionVO, data *StudentDataVO, cache *MediaCache) ([]*SchoolMap, error) {
+	var maps []*SchoolMap
+	var errs []error
+	for _, school := range NewSchoolIndex(data).Schools() {
+		if school.School == nil {
+			continue
+		}
+		schoolMap, err := client.SchoolMap(session, school.School, cache)
+		switch {
+		case errors.Is(err, ErrNoMedia):
+		case err != nil:
+			errs = append(errs, fmt.Errorf("school %d: %w", school.Number, err))
+		default:
+			maps = append(maps, schoolMap)
+		}
+	}
+	return maps, errors.Join(errs...)
+}
+
+// mapContentType normalizes a MapMimeType such as "image/JPEG" or "pdf",
+// falling back to the type detected from data.
+func mapContentType(mimeType string, data []byte) string {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if mimeType != "" && !strings.Contains(mimeType, "/") {
+		if byExtension := mime.TypeByExtension("." + mimeType); byExtension != "" {
+			mimeType = byExtension
+		}
+	}
+	if parsed, _, err := mime.ParseMediaType(mimeType); err == nil && strings.Contains(parsed, "/") {
+		if parsed == "image/jpg" || parsed == "image/pjpeg" {
+			return "image/jpeg"
+		}
+		return parsed
+	}
+	if detected := mediaType(data); detected != "" {
+		return detected
+	}
+	return "application/octet-stream"
+}
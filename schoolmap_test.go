@@ -0,0 +1,137 @@
+package gopowerschool
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func encodedImage(t *testing.T, encode func(io.Writer, image.Image) error) []byte {
+	img := image.NewPaletted(image.Rect(0, 0, 4, 2), color.Palette{color.Black, color.White})
+	var buf bytes.Buffer
+	if err := encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestMapContentType(t *testing.T) {
+	pngData := encodedImage(t, png.Encode)
+	for _, test := range []struct {
+		mimeType string
+		data     []byte
+		want     string
+	}{
+		{"image/JPEG", nil, "image/jpeg"},
+		{" image/jpg ", nil, "image/jpeg"},
+		{"image/pjpeg", nil, "image/jpeg"},
+		{"pdf", nil, "application/pdf"},
+		{"PNG", nil, "image/png"},
+		{"image/png; name=map.png", nil, "image/png"},
+		{"", pngData, "image/png"},
+		{"unknown", pngData, "image/png"},
+		{"", []byte("GIF89a...."), "image/gif"},
+		{"", []byte("not a map"), "application/octet-stream"},
+	} {
+		if got := mapContentType(test.mimeType, test.data); got != test.want {
+			t.Errorf("mapContentType(%q, %.8q) = %q, want %q", test.mimeType, test.data, got, test.want)
+		}
+	}
+}
+
+// mapServer serves maps[n] as the base64 map of school n, an empty reply
+// for schools without one, and a fault for school 99. It counts the maps
+// downloaded.
+func mapServer(t *testing.T, maps map[int64][]byte) (*PublicPortalServiceJSONPortType, *int) {
+	downloads := 0
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if strings.Contains(string(body), "<schoolNumber>99</schoolNumber>") {
+			io.WriteString(w, faultResponse)
+			return
+		}
+		for number, data := range maps {
+			if strings.Contains(string(body), fmt.Sprintf("<schoolNumber>%d</schoolNumber>", number)) {
+				downloads++
+				io.WriteString(w, soapResponse("getSchoolMapBySchoolNumber", base64.StdEncoding.EncodeToString(data)))
+				return
+			}
+		}
+		io.WriteString(w, soapResponse("getSchoolMapBySchoolNumber", ""))
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &downloads
+}
+
+func TestSchoolMap(t *testing.T) {
+	gifData := encodedImage(t, func(w io.Writer, img image.Image) error { return gif.Encode(w, img, nil) })
+	client, downloads := mapServer(t, map[int64][]byte{1: gifData})
+	cache := &MediaCache{Dir: t.TempDir()}
+	school := &SchoolVO{SchoolNumber: 1, SchoolMapModifiedDate: "2024-08-01"}
+
+	for i := 0; i < 2; i++ {
+		schoolMap, err := client.SchoolMap(&UserSessionVO{}, school, cache)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schoolMap.ContentType != "image/gif" || schoolMap.Image == nil || schoolMap.Image.Bounds().Dx() != 4 {
+			t.Errorf("map = %s %v", schoolMap.ContentType, schoolMap.Image)
+		}
+	}
+	if *downloads != 1 {
+		t.Errorf("downloaded the map %d times, want once", *downloads)
+	}
+
+	// A corrupt cache entry is replaced by a fresh download.
+	key := fmt.Sprintf("%s/map/%d", client.client.URL(), school.SchoolNumber)
+	if err := cache.store(key, school.SchoolMapModifiedDate, "image/gif", gifData[:10]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.SchoolMap(&UserSessionVO{}, school, cache); err != nil {
+		t.Fatalf("SchoolMap with a corrupt cache entry: %v", err)
+	}
+	if data, _, ok := cache.load(key, school.SchoolMapModifiedDate); !ok || !bytes.Equal(data, gifData) || *downloads != 2 {
+		t.Errorf("cache holds %d bytes after %d downloads", len(data), *downloads)
+	}
+
+	if _, err := client.SchoolMap(&UserSessionVO{}, &SchoolVO{SchoolNumber: 2}, cache); !errors.Is(err, ErrNoMedia) {
+		t.Errorf("SchoolMap of a school without a map = %v, want %v", err, ErrNoMedia)
+	}
+}
+
+func TestSchoolMaps(t *testing.T) {
+	pdf := []byte("%PDF-1.4 campus map")
+	client, _ := mapServer(t, map[int64][]byte{1: encodedImage(t, png.Encode), 3: pdf})
+	data := &StudentDataVO{
+		Schools:       []*SchoolVO{{SchoolNumber: 1}, {SchoolNumber: 2}, {SchoolNumber: 99}},
+		RemoteSchools: []*SchoolVO{{SchoolNumber: 3, MapMimeType: "pdf"}},
+		Sections:      []*SectionVO{{Id: 1, SchoolNumber: 4}},
+	}
+
+	maps, err := client.SchoolMaps(&UserSessionVO{}, data, nil)
+	if err == nil || !strings.Contains(err.Error(), "school 99") {
+		t.Errorf("SchoolMaps() error = %v, want the failure of school 99", err)
+	}
+	if len(maps) != 2 {
+		t.Fatalf("got %d maps, want the maps of schools 1 and 3", len(maps))
+	}
+	if maps[0].School.SchoolNumber != 1 || maps[0].ContentType != "image/png" || maps[0].Image == nil {
+		t.Errorf("first map = %+v", maps[0])
+	}
+	if maps[1].School.SchoolNumber != 3 || maps[1].ContentType != "application/pdf" || maps[1].Image != nil || !bytes.Equal(maps[1].Data, pdf) {
+		t.Errorf("second map = %+v", maps[1])
+	}
+}
@@ -0,0 +1,198 @@
+package gopowerschool
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// SchoolData is one school a student is enrolled at, with the parts of their
+// StudentDataVO that belong to it.
+type SchoolData struct {
+	// Number is the school number.
+	Number int64
+	// School merges every SchoolVO listed for the school. It is nil when
+	// data refers to a school the response does not describe.
+	School *SchoolVO
+	// Remote is set for secondary enrollments, listed only in RemoteSchools,
+	// such as a technical center attended alongside the home school.
+	Remote bool
+
+	Sections            []*SectionVO
+	Terms               []*TermVO
+	ReportingTerms      []*ReportingTermVO
+	FinalGrades         []*FinalGradeVO
+	ArchivedFinalGrades []*ArchivedFinalGradeVO
+}
+
+// SchoolIndex attributes the sections, terms and grades of a StudentDataVO
+// to the schools in its Schools and RemoteSchools, keyed by school number.
+//
+// Sections and terms name their school by number. Final grades are
+// attributed through their section. Reporting terms and archived grades
+// carry a school ID, which is matched against SchoolVO.SchoolId only;
+// those naming no listed school are left out.
+type SchoolIndex struct {
+	schools  []*SchoolData
+	byNumber map[int64]*SchoolData
+	byID     map[int64]*SchoolData
+	sections map[int64]*SectionVO
+}
+
+// NewSchoolIndex indexes data. The SchoolVOs in data are not modified.
+func NewSchoolIndex(data *StudentDataVO) *SchoolIndex {
+	index := &SchoolIndex{
+		byNumber: map[int64]*SchoolData{},
+		byID:     map[int64]*SchoolData{},
+		sections: map[int64]*SectionVO{},
+	}
+	if data == nil {
+		return index
+	}
+	index.addSchools(data.Schools, false)
+	index.addSchools(data.RemoteSchools, true)
+
+	for _, section := range data.Sections {
+		if section == nil {
+			continue
+		}
+		index.sections[section.Id] = section
+		school := index.number(section.SchoolNumber)
+		school.Sections = append(school.Sections, section)
+	}
+	for _, term := range data.Terms {
+		if term == nil {
+			continue
+		}
+		if number, err := strconv.ParseInt(term.SchoolNumber, 10, 64); err == nil {
+			school := index.number(number)
+			school.Terms = append(school.Terms, term)
+		}
+	}
+	for _, term := range data.ReportingTerms {
+		if term == nil {
+			continue
+		}
+		if school := index.SchoolByID(term.Schoolid); school != nil {
+			school.ReportingTerms = append(school.ReportingTerms, term)
+		}
+	}
+	for _, grade := range data.FinalGrades {
+		if school := index.SchoolOfFinalGrade(grade); school != nil {
+			school.FinalGrades = append(school.FinalGrades, grade)
+		}
+	}
+	for _, grade := range data.ArchivedFinalGrades {
+		if school := index.SchoolOfArchivedGrade(grade); school != nil {
+			school.ArchivedFinalGrades = append(school.ArchivedFinalGrades, grade)
+		}
+	}
+	return index
+}
+
+func (index *SchoolIndex) addSchools(schools []*SchoolVO, remote bool) {
+	for _, school := range schools {
+		if school == nil {
+			continue
+		}
+		data := index.number(school.SchoolNumber)
+		if data.School == nil {
+			merged := *school
+			data.School = &merged
+			data.Remote = remote
+		} else {
+			mergeSchool(data.School, school)
+		}
+		if school.SchoolId != 0 {
+			index.byID[school.SchoolId] = data
+		}
+	}
+}
+
+// mergeSchool fills the zero fields of dst from src.
+func mergeSchool(dst, src *SchoolVO) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < d.NumField(); i++ {
+		if field := d.Field(i); field.CanSet() && field.IsZero() {
+			field.Set(s.Field(i))
+		}
+	}
+}
+
+// number returns the school with a number, adding an undescribed one if
+// needed.
+func (index *SchoolIndex) number(number int64) *SchoolData {
+	data, ok := index.byNumber[number]
+	if !ok {
+		data = &SchoolData{Number: number}
+		index.byNumber[number] = data
+		index.schools = append(index.schools, data)
+	}
+	return data
+}
+
+// Schools returns every school in the order Schools and RemoteSchools list
+// them, followed by undescribed schools in order of number.
+func (index *SchoolIndex) Schools() []*SchoolData {
+	var described, undescribed []*SchoolData
+	for _, data := range index.schools {
+		if data.School != nil {
+			described = append(described, data)
+		} else {
+			undescribed = append(undescribed, data)
+		}
+	}
+	sort.SliceStable(undescribed, func(i, j int) bool {
+		return undescribed[i].Number < undescribed[j].Number
+	})
+	return append(described, undescribed...)
+}
+
+// School returns the school with a number, or nil.
+func (index *SchoolIndex) School(number int64) *SchoolData {
+	return index.byNumber[number]
+}
+
+// SchoolByID returns the school whose SchoolVO has a SchoolId, or nil.
+func (index *SchoolIndex) SchoolByID(id int64) *SchoolData {
+	return index.byID[id]
+}
+
+// SchoolOfSection returns the school teaching section, or nil.
+func (index *SchoolIndex) SchoolOfSection(section *SectionVO) *SchoolData {
+	if section == nil {
+		return nil
+	}
+	return index.byNumber[section.SchoolNumber]
+}
+
+// SchoolOfTerm returns the school a term belongs to, or nil.
+func (index *SchoolIndex) SchoolOfTerm(term *TermVO) *SchoolData {
+	if term == nil {
+		return nil
+	}
+	number, err := strconv.ParseInt(term.SchoolNumber, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return index.byNumber[number]
+}
+
+// SchoolOfFinalGrade returns the school of the section a grade was given
+// in, or nil.
+func (index *SchoolIndex) SchoolOfFinalGrade(grade *FinalGradeVO) *SchoolData {
+	if grade == nil {
+		return nil
+	}
+	return index.SchoolOfSection(index.sections[grade.Sectionid])
+}
+
+// SchoolOfArchivedGrade returns the school that stored an archived grade,
+// or nil.
+func (index *SchoolIndex) SchoolOfArchivedGrade(grade *ArchivedFinalGradeVO) *SchoolData {
+	if grade == nil {
+		return nil
+	}
+	return index.SchoolByID(grade.SchoolId)
+}
@@ -0,0 +1,75 @@
+package gopowerschool
+
+import "testing"
+
+func TestSchoolIndex(t *testing.T) {
+	home := &SchoolVO{SchoolNumber: 100, SchoolId: 1, Name: "High School"}
+	data := &StudentDataVO{
+		Schools: []*SchoolVO{home, {SchoolNumber: 100, Abbreviation: "HS"}},
+		RemoteSchools: []*SchoolVO{
+			{SchoolNumber: 200, SchoolId: 2, Name: "Technical Center"},
+			{SchoolNumber: 100, Schoolcity: "Springfield"},
+		},
+		Sections: []*SectionVO{{Id: 10, SchoolNumber: 100}, {Id: 20, SchoolNumber: 200}, {Id: 30, SchoolNumber: 300}},
+		Terms:    []*TermVO{{Id: 1, SchoolNumber: "100"}, {Id: 2, SchoolNumber: "two hundred"}},
+		ReportingTerms: []*ReportingTermVO{
+			{Id: 1, Schoolid: 1},
+			{Id: 2, Schoolid: 2},
+			// A school number is not a school ID.
+			{Id: 3, Schoolid: 100},
+		},
+		FinalGrades:         []*FinalGradeVO{{Id: 1, Sectionid: 20}, {Id: 2, Sectionid: 99}},
+		ArchivedFinalGrades: []*ArchivedFinalGradeVO{{SchoolId: 2}, {SchoolId: 200}},
+	}
+	index := NewSchoolIndex(data)
+
+	schools := index.Schools()
+	if len(schools) != 3 || schools[0].Number != 100 || schools[1].Number != 200 || schools[2].Number != 300 {
+		t.Fatalf("schools = %v", schools)
+	}
+	high, tech, undescribed := schools[0], schools[1], schools[2]
+	if high.Remote || !tech.Remote || undescribed.School != nil {
+		t.Errorf("remote = %v, %v; undescribed school = %v", high.Remote, tech.Remote, undescribed.School)
+	}
+	if s := high.School; s.Name != "High School" || s.Abbreviation != "HS" || s.Schoolcity != "Springfield" {
+		t.Errorf("merged school = %+v", s)
+	}
+	if home.Abbreviation != "" {
+		t.Error("NewSchoolIndex modified a SchoolVO of data")
+	}
+
+	counts := func(school *SchoolData) [5]int {
+		return [5]int{len(school.Sections), len(school.Terms), len(school.ReportingTerms), len(school.FinalGrades), len(school.ArchivedFinalGrades)}
+	}
+	for _, test := range []struct {
+		school *SchoolData
+		want   [5]int
+	}{
+		{high, [5]int{1, 1, 1, 0, 0}},
+		{tech, [5]int{1, 0, 1, 1, 1}},
+		{undescribed, [5]int{1, 0, 0, 0, 0}},
+	} {
+		if got := counts(test.school); got != test.want {
+			t.Errorf("school %d has sections, terms, reporting terms, final and archived grades %v, want %v", test.school.Number, got, test.want)
+		}
+	}
+	if high.ReportingTerms[0].Id != 1 {
+		t.Errorf("reporting term %d was attributed to school 100", high.ReportingTerms[0].Id)
+	}
+
+	if index.School(200) != tech || index.School(2) != nil {
+		t.Error("School looks schools up by something other than number")
+	}
+	if index.SchoolByID(2) != tech || index.SchoolByID(200) != nil {
+		t.Error("SchoolByID looks schools up by something other than ID")
+	}
+	if index.SchoolOfSection(data.Sections[1]) != tech || index.SchoolOfTerm(data.Terms[1]) != nil {
+		t.Error("sections or terms attributed to the wrong school")
+	}
+	if index.SchoolOfFinalGrade(data.FinalGrades[1]) != nil || index.SchoolOfArchivedGrade(data.ArchivedFinalGrades[1]) != nil {
+		t.Error("grades without a known school were attributed to one")
+	}
+	if len(NewSchoolIndex(nil).Schools()) != 0 {
+		t.Error("an index of no data lists schools")
+	}
+}
@@ -0,0 +1,36 @@
+package gopowerschool
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// soapResponse wraps the return value of a publicportal operation in a SOAP
+// 1.1 envelope.
+func soapResponse(operation, value string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>`+
+		`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body>`+
+		`<ns:%[1]sResponse xmlns:ns="http://publicportal.rest.powerschool.pearson.com/xsd"><ns:return>%[2]s</ns:return></ns:%[1]sResponse>`+
+		`</soapenv:Body></soapenv:Envelope>`, operation, value)
+}
+
+// serviceHandler stands in for a PowerSchool server: it answers the empty
+// request the client opens with by a digest challenge, and passes every call
+// to respond.
+func serviceHandler(t *testing.T, respond func(w http.ResponseWriter, r *http.Request, body []byte)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request: %v", err)
+			return
+		}
+		if len(body) == 0 {
+			w.Header().Set("WWW-Authenticate", `Digest realm="PowerSchool", nonce="abc", qop="auth"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		respond(w, r, body)
+	}
+}
@@ -0,0 +1,1556 @@
+// Code generated by wsdlgen from testdata/PublicPortalServiceJSON.wsdl. DO NOT EDIT.
+
+package gopowerschool
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type Locale struct {
+	XMLName xml.Name `xml:"locale" json:"-"`
+
+	ISO3Country            string `xml:"ISO3Country,omitempty" json:"ISO3Country,omitempty"`
+	ISO3Language           string `xml:"ISO3Language,omitempty" json:"ISO3Language,omitempty"`
+	Country                string `xml:"country,omitempty" json:"country,omitempty"`
+	DisplayCountry         string `xml:"displayCountry,omitempty" json:"displayCountry,omitempty"`
+	DisplayLanguage        string `xml:"displayLanguage,omitempty" json:"displayLanguage,omitempty"`
+	DisplayName            string `xml:"displayName,omitempty" json:"displayName,omitempty"`
+	DisplayScript          string `xml:"displayScript,omitempty" json:"displayScript,omitempty"`
+	DisplayVariant         string `xml:"displayVariant,omitempty" json:"displayVariant,omitempty"`
+	ExtensionKeys          *Set   `xml:"extensionKeys,omitempty" json:"extensionKeys,omitempty"`
+	Language               string `xml:"language,omitempty" json:"language,omitempty"`
+	Script                 string `xml:"script,omitempty" json:"script,omitempty"`
+	UnicodeLocaleAttribute *Set   `xml:"unicodeLocaleAttributes,omitempty" json:"unicodeLocaleAttributes,omitempty"`
+	UnicodeLocaleKeys      *Set   `xml:"unicodeLocaleKeys,omitempty" json:"unicodeLocaleKeys,omitempty"`
+	Variant                string `xml:"variant,omitempty" json:"variant,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *Locale) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain Locale
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type Set struct {
+	XMLName xml.Name `json:"-"`
+
+	Empty bool `xml:"empty,omitempty" json:"empty,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *Set) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain Set
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type Login struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd login" json:"-"`
+
+	Username string `xml:"username,omitempty" json:"username,omitempty"`
+	Password string `xml:"password,omitempty" json:"password,omitempty"`
+	UserType int32  `xml:"userType,omitempty" json:"userType,omitempty"`
+}
+
+type LoginResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd loginResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type Logout struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd logout" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+}
+
+type LogoutResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd logoutResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetSchoolMapBySchoolNumber struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getSchoolMapBySchoolNumber" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	SchoolNumber  int64          `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+}
+
+type GetSchoolMapBySchoolNumberResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getSchoolMapBySchoolNumberResponse" json:"-"`
+
+	Return_ []byte `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetStudentData struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStudentData" json:"-"`
+
+	UserSessionVO *UserSessionVO      `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	StudentIDs    []int64             `xml:"studentIDs,omitempty" json:"studentIDs,omitempty"`
+	Qil           *QueryIncludeListVO `xml:"qil,omitempty" json:"qil,omitempty"`
+}
+
+type GetStudentDataResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStudentDataResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetStudentPhoto struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStudentPhoto" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	StudentID     int64          `xml:"studentID,omitempty" json:"studentID,omitempty"`
+}
+
+type GetStudentPhotoResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStudentPhotoResponse" json:"-"`
+
+	Return_ []byte `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type RecoverUsername struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd recoverUsername" json:"-"`
+
+	EmailAddress string `xml:"emailAddress,omitempty" json:"emailAddress,omitempty"`
+}
+
+type RecoverUsernameResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd recoverUsernameResponse" json:"-"`
+
+	Return_ *MessageVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type RecoverPassword struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd recoverPassword" json:"-"`
+
+	UserType      int32  `xml:"userType,omitempty" json:"userType,omitempty"`
+	UserName      string `xml:"userName,omitempty" json:"userName,omitempty"`
+	RecoveryToken string `xml:"recoveryToken,omitempty" json:"recoveryToken,omitempty"`
+	NewPassword   string `xml:"newPassword,omitempty" json:"newPassword,omitempty"`
+}
+
+type RecoverPasswordResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd recoverPasswordResponse" json:"-"`
+
+	Return_ *PasswordResetVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type LinkDeviceTokenToUser struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd linkDeviceTokenToUser" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	DeviceToken   string         `xml:"deviceToken,omitempty" json:"deviceToken,omitempty"`
+}
+
+type LinkDeviceTokenToUserResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd linkDeviceTokenToUserResponse" json:"-"`
+
+	Return_ *MessageVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type StoreCourseRequests struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd storeCourseRequests" json:"-"`
+
+	UserSessionVO       *UserSessionVO          `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	StudentId           int64                   `xml:"studentId,omitempty" json:"studentId,omitempty"`
+	CourseRequestGroups []*CourseRequestGroupVO `xml:"courseRequestGroups,omitempty" json:"courseRequestGroups,omitempty"`
+}
+
+type StoreCourseRequestsResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd storeCourseRequestsResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetCredentialComplexityRules struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getCredentialComplexityRules" json:"-"`
+
+	UserType int32 `xml:"userType,omitempty" json:"userType,omitempty"`
+}
+
+type GetCredentialComplexityRulesResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getCredentialComplexityRulesResponse" json:"-"`
+
+	Return_ *CredentialComplexityRulesVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type StoreNotificationSettings struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd storeNotificationSettings" json:"-"`
+
+	UserSessionVO *UserSessionVO          `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	Ns            *NotificationSettingsVO `xml:"ns,omitempty" json:"ns,omitempty"`
+}
+
+type StoreNotificationSettingsResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd storeNotificationSettingsResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type LogoutAndDelinkDeviceToken struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd logoutAndDelinkDeviceToken" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	DeviceToken   string         `xml:"deviceToken,omitempty" json:"deviceToken,omitempty"`
+}
+
+type LogoutAndDelinkDeviceTokenResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd logoutAndDelinkDeviceTokenResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type LoginToPublicPortal struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd loginToPublicPortal" json:"-"`
+
+	Username string `xml:"username,omitempty" json:"username,omitempty"`
+	Password string `xml:"password,omitempty" json:"password,omitempty"`
+}
+
+type LoginToPublicPortalResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd loginToPublicPortalResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetStartStopTimeForAllSections struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStartStopTimeForAllSections" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	StudentIDs    []int64        `xml:"studentIDs,omitempty" json:"studentIDs,omitempty"`
+	Month         int32          `xml:"month,omitempty" json:"month,omitempty"`
+	Year          int32          `xml:"year,omitempty" json:"year,omitempty"`
+}
+
+type GetStartStopTimeForAllSectionsResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getStartStopTimeForAllSectionsResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type GetAllCourseRequests struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getAllCourseRequests" json:"-"`
+
+	UserSessionVO *UserSessionVO `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+	StudentId     int64          `xml:"studentId,omitempty" json:"studentId,omitempty"`
+}
+
+type GetAllCourseRequestsResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd getAllCourseRequestsResponse" json:"-"`
+
+	Return_ *ResultsVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type SendPasswordRecoveryEmail struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd sendPasswordRecoveryEmail" json:"-"`
+
+	UserType     int32  `xml:"userType,omitempty" json:"userType,omitempty"`
+	UserName     string `xml:"userName,omitempty" json:"userName,omitempty"`
+	EmailAddress string `xml:"emailAddress,omitempty" json:"emailAddress,omitempty"`
+}
+
+type SendPasswordRecoveryEmailResponse struct {
+	XMLName xml.Name `xml:"http://publicportal.rest.powerschool.pearson.com/xsd sendPasswordRecoveryEmailResponse" json:"-"`
+
+	Return_ *MessageVO `xml:"return,omitempty" json:"return,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type BaseResultsVO struct {
+	XMLName xml.Name `json:"-"`
+
+	MessageVOs []*MessageVO `xml:"messagesVO,omitempty" json:"messagesVO,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+type MessageVO struct {
+	XMLName xml.Name `json:"-"`
+
+	Description string `xml:"description,omitempty" json:"description,omitempty"`
+	Id          string `xml:"id,omitempty" json:"id,omitempty"`
+	MsgCode     int32  `xml:"msgCode,omitempty" json:"msgCode,omitempty"`
+	Title       string `xml:"title,omitempty" json:"title,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *MessageVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain MessageVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type ResultsVO struct {
+	XMLName xml.Name `xml:"return" json:"-"`
+
+	MessageVOs             []*MessageVO            `xml:"messageVOs,omitempty" json:"messageVOs,omitempty"`
+	CourseRequestGroupsVOs []*CourseRequestGroupVO `xml:"courseRequestGroupsVOs,omitempty" json:"courseRequestGroupsVOs,omitempty"`
+	CourseRequestRulesVO   *CourseRequestRulesVO   `xml:"courseRequestRulesVO,omitempty" json:"courseRequestRulesVO,omitempty"`
+	StudentDataVOs         []*StudentDataVO        `xml:"studentDataVOs,omitempty" json:"studentDataVOs,omitempty"`
+	UserSessionVO          *UserSessionVO          `xml:"userSessionVO,omitempty" json:"userSessionVO,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *ResultsVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain ResultsVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CourseRequestGroupVO struct {
+	XMLName xml.Name `json:"-"`
+
+	Courses        []*CourseRequestVO `xml:"courses,omitempty" json:"courses,omitempty"`
+	Description    string             `xml:"description,omitempty" json:"description,omitempty"`
+	EmptyAdvice    string             `xml:"emptyAdvice,omitempty" json:"emptyAdvice,omitempty"`
+	GradeLevel     int32              `xml:"gradeLevel,omitempty" json:"gradeLevel,omitempty"`
+	Id             int64              `xml:"id,omitempty" json:"id,omitempty"`
+	ItemType       string             `xml:"itemType,omitempty" json:"itemType,omitempty"`
+	MaxCourseCount float32            `xml:"maxCourseCount,omitempty" json:"maxCourseCount,omitempty"`
+	MinCourseCount float32            `xml:"minCourseCount,omitempty" json:"minCourseCount,omitempty"`
+	Name           string             `xml:"name,omitempty" json:"name,omitempty"`
+	RequestType    string             `xml:"requestType,omitempty" json:"requestType,omitempty"`
+	Requests       []*CourseRequestVO `xml:"requests,omitempty" json:"requests,omitempty"`
+	SchoolId       int64              `xml:"schoolId,omitempty" json:"schoolId,omitempty"`
+	SortOrder      int32              `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	YearId         int32              `xml:"yearId,omitempty" json:"yearId,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CourseRequestGroupVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CourseRequestGroupVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CourseRequestVO struct {
+	XMLName xml.Name `json:"-"`
+
+	CourseName   string  `xml:"courseName,omitempty" json:"courseName,omitempty"`
+	CourseNumber string  `xml:"courseNumber,omitempty" json:"courseNumber,omitempty"`
+	CreditHours  float32 `xml:"creditHours,omitempty" json:"creditHours,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CourseRequestVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CourseRequestVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CourseRequestRulesVO struct {
+	XMLName xml.Name `xml:"courseRequestRulesVO" json:"-"`
+
+	Description string  `xml:"description,omitempty" json:"description,omitempty"`
+	MaxCredits  float64 `xml:"maxCredits,omitempty" json:"maxCredits,omitempty"`
+	MinCredits  float64 `xml:"minCredits,omitempty" json:"minCredits,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CourseRequestRulesVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CourseRequestRulesVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type StudentDataVO struct {
+	XMLName xml.Name `xml:"studentDataVOs" json:"-"`
+
+	Activities             []*ActivityVO           `xml:"activities,omitempty" json:"activities,omitempty"`
+	ArchivedFinalGrades    []*ArchivedFinalGradeVO `xml:"archivedFinalGrades,omitempty" json:"archivedFinalGrades,omitempty"`
+	AssignmentCategories   []*AsmtCatVO            `xml:"assignmentCategories,omitempty" json:"assignmentCategories,omitempty"`
+	AssignmentScores       []*AssignmentScoreVO    `xml:"assignmentScores,omitempty" json:"assignmentScores,omitempty"`
+	Assignments            []*AssignmentVO         `xml:"assignments,omitempty" json:"assignments,omitempty"`
+	Attendance             []*AttendanceVO         `xml:"attendance,omitempty" json:"attendance,omitempty"`
+	AttendanceCodes        []*AttendanceCodeVO     `xml:"attendanceCodes,omitempty" json:"attendanceCodes,omitempty"`
+	Bulletins              []*BulletinLite         `xml:"bulletins,omitempty" json:"bulletins,omitempty"`
+	CitizenCodes           []*CitizenCodeVO        `xml:"citizenCodes,omitempty" json:"citizenCodes,omitempty"`
+	CitizenGrades          []*CitizenGradeVO       `xml:"citizenGrades,omitempty" json:"citizenGrades,omitempty"`
+	CourseRequests         []*CourseRequestVO      `xml:"courseRequests,omitempty" json:"courseRequests,omitempty"`
+	Enrollments            []*SectionEnrollmentVO  `xml:"enrollments,omitempty" json:"enrollments,omitempty"`
+	Extension              *RawElement             `xml:"extension,omitempty" json:"extension,omitempty"`
+	FeeBalance             *FeeBalanceVO           `xml:"feeBalance,omitempty" json:"feeBalance,omitempty"`
+	FeeTransactions        []*FeeTransactionVO     `xml:"feeTransactions,omitempty" json:"feeTransactions,omitempty"`
+	FeeTypes               []*FeeTypeVO            `xml:"feeTypes,omitempty" json:"feeTypes,omitempty"`
+	FinalGrades            []*FinalGradeVO         `xml:"finalGrades,omitempty" json:"finalGrades,omitempty"`
+	GradeScales            []*GradeScaleVO         `xml:"gradeScales,omitempty" json:"gradeScales,omitempty"`
+	LunchTransactions      []*LunchTransactionVO   `xml:"lunchTransactions,omitempty" json:"lunchTransactions,omitempty"`
+	NotInSessionDays       []*NotInSessionDayVO    `xml:"notInSessionDays,omitempty" json:"notInSessionDays,omitempty"`
+	NotificationSettingsVO *NotificationSettingsVO `xml:"notificationSettingsVO,omitempty" json:"notificationSettingsVO,omitempty"`
+	Periods                []*PeriodVO             `xml:"periods,omitempty" json:"periods,omitempty"`
+	RemoteSchools          []*SchoolVO             `xml:"remoteSchools,omitempty" json:"remoteSchools,omitempty"`
+	ReportingTerms         []*ReportingTermVO      `xml:"reportingTerms,omitempty" json:"reportingTerms,omitempty"`
+	Schools                []*SchoolVO             `xml:"schools,omitempty" json:"schools,omitempty"`
+	Sections               []*SectionVO            `xml:"sections,omitempty" json:"sections,omitempty"`
+	Standards              []*StandardVO           `xml:"standards,omitempty" json:"standards,omitempty"`
+	StandardsGrades        []*StandardGradeVO      `xml:"standardsGrades,omitempty" json:"standardsGrades,omitempty"`
+	Student                *StudentVO              `xml:"student,omitempty" json:"student,omitempty"`
+	StudentDcid            int64                   `xml:"studentDcid,omitempty" json:"studentDcid,omitempty"`
+	StudentId              int64                   `xml:"studentId,omitempty" json:"studentId,omitempty"`
+	Teachers               []*TeacherVO            `xml:"teachers,omitempty" json:"teachers,omitempty"`
+	Terms                  []*TermVO               `xml:"terms,omitempty" json:"terms,omitempty"`
+	YearId                 int32                   `xml:"yearId,omitempty" json:"yearId,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *StudentDataVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain StudentDataVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type ActivityVO struct {
+	XMLName xml.Name `xml:"activities" json:"-"`
+
+	Category string `xml:"category,omitempty" json:"category,omitempty"`
+	Id       int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Name     string `xml:"name,omitempty" json:"name,omitempty"`
+	Required bool   `xml:"required,omitempty" json:"required,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *ActivityVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain ActivityVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type FinalGradeVO struct {
+	XMLName xml.Name `xml:"finalGrades" json:"-"`
+
+	CommentValue    string  `xml:"commentValue,omitempty" json:"commentValue,omitempty"`
+	DateStored      string  `xml:"dateStored,omitempty" json:"dateStored,omitempty"`
+	Grade           string  `xml:"grade,omitempty" json:"grade,omitempty"`
+	Id              int64   `xml:"id,omitempty" json:"id,omitempty"`
+	Percent         float64 `xml:"percent,omitempty" json:"percent,omitempty"`
+	ReportingTermId int64   `xml:"reportingTermId,omitempty" json:"reportingTermId,omitempty"`
+	Sectionid       int64   `xml:"sectionid,omitempty" json:"sectionid,omitempty"`
+	StoreType       int32   `xml:"storeType,omitempty" json:"storeType,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *FinalGradeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain FinalGradeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type ArchivedFinalGradeVO struct {
+	XMLName xml.Name `xml:"archivedFinalGrades" json:"-"`
+
+	*FinalGradeVO
+
+	CourseName    string `xml:"courseName,omitempty" json:"courseName,omitempty"`
+	CourseNumber  string `xml:"courseNumber,omitempty" json:"courseNumber,omitempty"`
+	SchoolId      int64  `xml:"schoolId,omitempty" json:"schoolId,omitempty"`
+	SortOrder     int32  `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	StoreCode     string `xml:"storeCode,omitempty" json:"storeCode,omitempty"`
+	TeacherName   string `xml:"teacherName,omitempty" json:"teacherName,omitempty"`
+	TermEndDate   string `xml:"termEndDate,omitempty" json:"termEndDate,omitempty"`
+	TermId        int64  `xml:"termId,omitempty" json:"termId,omitempty"`
+	TermStartDate string `xml:"termStartDate,omitempty" json:"termStartDate,omitempty"`
+	YearId        int64  `xml:"yearId,omitempty" json:"yearId,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *ArchivedFinalGradeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain ArchivedFinalGradeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type AsmtCatVO struct {
+	XMLName xml.Name `xml:"assignmentCategories" json:"-"`
+
+	Abbreviation  string `xml:"abbreviation,omitempty" json:"abbreviation,omitempty"`
+	Description   string `xml:"description,omitempty" json:"description,omitempty"`
+	GradeBookType int32  `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	Id            int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Name          string `xml:"name,omitempty" json:"name,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *AsmtCatVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AsmtCatVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type AssignmentScoreVO struct {
+	XMLName xml.Name `xml:"assignmentScores" json:"-"`
+
+	AssignmentId  int64  `xml:"assignmentId,omitempty" json:"assignmentId,omitempty"`
+	Collected     bool   `xml:"collected,omitempty" json:"collected,omitempty"`
+	Comment       string `xml:"comment,omitempty" json:"comment,omitempty"`
+	Exempt        bool   `xml:"exempt,omitempty" json:"exempt,omitempty"`
+	GradeBookType int32  `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	Id            int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Late          bool   `xml:"late,omitempty" json:"late,omitempty"`
+	LetterGrade   string `xml:"letterGrade,omitempty" json:"letterGrade,omitempty"`
+	Missing       bool   `xml:"missing,omitempty" json:"missing,omitempty"`
+	Percent       string `xml:"percent,omitempty" json:"percent,omitempty"`
+	Score         string `xml:"score,omitempty" json:"score,omitempty"`
+	Scoretype     int32  `xml:"scoretype,omitempty" json:"scoretype,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *AssignmentScoreVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AssignmentScoreVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type AssignmentVO struct {
+	XMLName xml.Name `xml:"assignments" json:"-"`
+
+	Abbreviation          string    `xml:"abbreviation,omitempty" json:"abbreviation,omitempty"`
+	AdditionalCategoryIds []int32   `xml:"additionalCategoryIds,omitempty" json:"additionalCategoryIds,omitempty"`
+	Assignmentid          int64     `xml:"assignmentid,omitempty" json:"assignmentid,omitempty"`
+	CategoryId            int32     `xml:"categoryId,omitempty" json:"categoryId,omitempty"`
+	Description           string    `xml:"description,omitempty" json:"description,omitempty"`
+	DueDate               time.Time `xml:"dueDate,omitempty" json:"dueDate,omitempty"`
+	GradeBookType         int32     `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	Id                    int64     `xml:"id,omitempty" json:"id,omitempty"`
+	Includeinfinalgrades  int32     `xml:"includeinfinalgrades,omitempty" json:"includeinfinalgrades,omitempty"`
+	Name                  string    `xml:"name,omitempty" json:"name,omitempty"`
+	Pointspossible        float64   `xml:"pointspossible,omitempty" json:"pointspossible,omitempty"`
+	PublishDaysBeforeDue  int32     `xml:"publishDaysBeforeDue,omitempty" json:"publishDaysBeforeDue,omitempty"`
+	PublishState          int32     `xml:"publishState,omitempty" json:"publishState,omitempty"`
+	Publishonspecificdate string    `xml:"publishonspecificdate,omitempty" json:"publishonspecificdate,omitempty"`
+	Publishscores         int32     `xml:"publishscores,omitempty" json:"publishscores,omitempty"`
+	SectionDcid           int64     `xml:"sectionDcid,omitempty" json:"sectionDcid,omitempty"`
+	Sectionid             int64     `xml:"sectionid,omitempty" json:"sectionid,omitempty"`
+	Type_                 int32     `xml:"type,omitempty" json:"type,omitempty"`
+	Weight                float64   `xml:"weight,omitempty" json:"weight,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *AssignmentVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AssignmentVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type AttendanceVO struct {
+	XMLName xml.Name `xml:"attendance" json:"-"`
+
+	AdaValueCode    float64 `xml:"adaValueCode,omitempty" json:"adaValueCode,omitempty"`
+	AdaValueTime    float64 `xml:"adaValueTime,omitempty" json:"adaValueTime,omitempty"`
+	AdmValue        float64 `xml:"admValue,omitempty" json:"admValue,omitempty"`
+	AttCodeid       int64   `xml:"attCodeid,omitempty" json:"attCodeid,omitempty"`
+	AttComment      string  `xml:"attComment,omitempty" json:"attComment,omitempty"`
+	AttDate         string  `xml:"attDate,omitempty" json:"attDate,omitempty"`
+	AttFlags        int32   `xml:"attFlags,omitempty" json:"attFlags,omitempty"`
+	AttInterval     int32   `xml:"attInterval,omitempty" json:"attInterval,omitempty"`
+	AttModeCode     string  `xml:"attModeCode,omitempty" json:"attModeCode,omitempty"`
+	Ccid            int64   `xml:"ccid,omitempty" json:"ccid,omitempty"`
+	Id              int64   `xml:"id,omitempty" json:"id,omitempty"`
+	Periodid        int64   `xml:"periodid,omitempty" json:"periodid,omitempty"`
+	Schoolid        int64   `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	Studentid       int64   `xml:"studentid,omitempty" json:"studentid,omitempty"`
+	TotalMinutes    float64 `xml:"totalMinutes,omitempty" json:"totalMinutes,omitempty"`
+	TransactionType string  `xml:"transactionType,omitempty" json:"transactionType,omitempty"`
+	Yearid          int32   `xml:"yearid,omitempty" json:"yearid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *AttendanceVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AttendanceVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type AttendanceCodeVO struct {
+	XMLName xml.Name `xml:"attendanceCodes" json:"-"`
+
+	AttCode     string `xml:"attCode,omitempty" json:"attCode,omitempty"`
+	CodeType    int32  `xml:"codeType,omitempty" json:"codeType,omitempty"`
+	Description string `xml:"description,omitempty" json:"description,omitempty"`
+	Id          int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Schoolid    int64  `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	Sortorder   int32  `xml:"sortorder,omitempty" json:"sortorder,omitempty"`
+	Yearid      int32  `xml:"yearid,omitempty" json:"yearid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *AttendanceCodeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain AttendanceCodeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CitizenCodeVO struct {
+	XMLName xml.Name `xml:"citizenCodes" json:"-"`
+
+	CodeName    string `xml:"codeName,omitempty" json:"codeName,omitempty"`
+	Description string `xml:"description,omitempty" json:"description,omitempty"`
+	Id          int64  `xml:"id,omitempty" json:"id,omitempty"`
+	SortOrder   int32  `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CitizenCodeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CitizenCodeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CitizenGradeVO struct {
+	XMLName xml.Name `xml:"citizenGrades" json:"-"`
+
+	CodeId          int64 `xml:"codeId,omitempty" json:"codeId,omitempty"`
+	ReportingTermId int64 `xml:"reportingTermId,omitempty" json:"reportingTermId,omitempty"`
+	SectionId       int64 `xml:"sectionId,omitempty" json:"sectionId,omitempty"`
+	StoreType       int32 `xml:"storeType,omitempty" json:"storeType,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CitizenGradeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CitizenGradeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type SectionEnrollmentVO struct {
+	XMLName xml.Name `xml:"enrollments" json:"-"`
+
+	EndDate      string `xml:"endDate,omitempty" json:"endDate,omitempty"`
+	EnrollStatus int32  `xml:"enrollStatus,omitempty" json:"enrollStatus,omitempty"`
+	Id           int64  `xml:"id,omitempty" json:"id,omitempty"`
+	StartDate    string `xml:"startDate,omitempty" json:"startDate,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *SectionEnrollmentVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain SectionEnrollmentVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type FeeBalanceVO struct {
+	XMLName xml.Name `xml:"feeBalance" json:"-"`
+
+	Balance  float64 `xml:"balance,omitempty" json:"balance,omitempty"`
+	Credit   float64 `xml:"credit,omitempty" json:"credit,omitempty"`
+	Debit    float64 `xml:"debit,omitempty" json:"debit,omitempty"`
+	Id       int64   `xml:"id,omitempty" json:"id,omitempty"`
+	Schoolid int64   `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	Yearid   int32   `xml:"yearid,omitempty" json:"yearid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *FeeBalanceVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain FeeBalanceVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type FeeTransactionVO struct {
+	XMLName xml.Name `xml:"feeTransactions" json:"-"`
+
+	Adjustment         float64 `xml:"adjustment,omitempty" json:"adjustment,omitempty"`
+	CourseName         string  `xml:"courseName,omitempty" json:"courseName,omitempty"`
+	CourseNumber       string  `xml:"courseNumber,omitempty" json:"courseNumber,omitempty"`
+	Creationdate       string  `xml:"creationdate,omitempty" json:"creationdate,omitempty"`
+	DateValue          string  `xml:"dateValue,omitempty" json:"dateValue,omitempty"`
+	DepartmentName     string  `xml:"departmentName,omitempty" json:"departmentName,omitempty"`
+	Description        string  `xml:"description,omitempty" json:"description,omitempty"`
+	FeeAmount          float64 `xml:"feeAmount,omitempty" json:"feeAmount,omitempty"`
+	FeeBalance         float64 `xml:"feeBalance,omitempty" json:"feeBalance,omitempty"`
+	FeeCategoryName    string  `xml:"feeCategoryName,omitempty" json:"feeCategoryName,omitempty"`
+	FeePaid            float64 `xml:"feePaid,omitempty" json:"feePaid,omitempty"`
+	FeeTypeId          int64   `xml:"feeTypeId,omitempty" json:"feeTypeId,omitempty"`
+	FeeTypeName        string  `xml:"feeTypeName,omitempty" json:"feeTypeName,omitempty"`
+	Feecharged         float64 `xml:"feecharged,omitempty" json:"feecharged,omitempty"`
+	GroupTransactionId int64   `xml:"groupTransactionId,omitempty" json:"groupTransactionId,omitempty"`
+	Id                 int64   `xml:"id,omitempty" json:"id,omitempty"`
+	Modificationdate   string  `xml:"modificationdate,omitempty" json:"modificationdate,omitempty"`
+	Originalfee        float64 `xml:"originalfee,omitempty" json:"originalfee,omitempty"`
+	Priority           int32   `xml:"priority,omitempty" json:"priority,omitempty"`
+	ProRated           int32   `xml:"proRated,omitempty" json:"proRated,omitempty"`
+	SchoolfeeId        int64   `xml:"schoolfeeId,omitempty" json:"schoolfeeId,omitempty"`
+	Schoolid           int64   `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	Termid             int64   `xml:"termid,omitempty" json:"termid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *FeeTransactionVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain FeeTransactionVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type FeeTypeVO struct {
+	XMLName xml.Name `xml:"feeTypes" json:"-"`
+
+	Descript        string `xml:"descript,omitempty" json:"descript,omitempty"`
+	FeeCategoryName string `xml:"feeCategoryName,omitempty" json:"feeCategoryName,omitempty"`
+	Id              int64  `xml:"id,omitempty" json:"id,omitempty"`
+	SchoolNumber    int32  `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+	Sort            int32  `xml:"sort,omitempty" json:"sort,omitempty"`
+	Title           string `xml:"title,omitempty" json:"title,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *FeeTypeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain FeeTypeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type GradeScaleVO struct {
+	XMLName xml.Name `xml:"gradeScales" json:"-"`
+
+	Description      string              `xml:"description,omitempty" json:"description,omitempty"`
+	GradeBookType    int32               `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	GradeScaleItems  []*GradeScaleItemVO `xml:"gradeScaleItems,omitempty" json:"gradeScaleItems,omitempty"`
+	Id               int64               `xml:"id,omitempty" json:"id,omitempty"`
+	Name             string              `xml:"name,omitempty" json:"name,omitempty"`
+	Numeric          int32               `xml:"numeric,omitempty" json:"numeric,omitempty"`
+	NumericMax       int32               `xml:"numericMax,omitempty" json:"numericMax,omitempty"`
+	NumericMin       int32               `xml:"numericMin,omitempty" json:"numericMin,omitempty"`
+	NumericPrecision int32               `xml:"numericPrecision,omitempty" json:"numericPrecision,omitempty"`
+	NumericScale     int32               `xml:"numericScale,omitempty" json:"numericScale,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *GradeScaleVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain GradeScaleVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type GradeScaleItemVO struct {
+	XMLName xml.Name `xml:"gradeScaleItems" json:"-"`
+
+	CutoffPercent     float64 `xml:"cutoffPercent,omitempty" json:"cutoffPercent,omitempty"`
+	DefaultZeroCutoff bool    `xml:"defaultZeroCutoff,omitempty" json:"defaultZeroCutoff,omitempty"`
+	Description       string  `xml:"description,omitempty" json:"description,omitempty"`
+	GradeBookType     int32   `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	GradeLabel        string  `xml:"gradeLabel,omitempty" json:"gradeLabel,omitempty"`
+	Id                int64   `xml:"id,omitempty" json:"id,omitempty"`
+	PercentValue      float64 `xml:"percentValue,omitempty" json:"percentValue,omitempty"`
+	PointsValue       float64 `xml:"pointsValue,omitempty" json:"pointsValue,omitempty"`
+	SortOrder         int32   `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *GradeScaleItemVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain GradeScaleItemVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type LunchTransactionVO struct {
+	XMLName xml.Name `xml:"lunchTransactions" json:"-"`
+
+	Cash        float64 `xml:"cash,omitempty" json:"cash,omitempty"`
+	Credit      float64 `xml:"credit,omitempty" json:"credit,omitempty"`
+	DateValue   string  `xml:"dateValue,omitempty" json:"dateValue,omitempty"`
+	Debit       float64 `xml:"debit,omitempty" json:"debit,omitempty"`
+	Description string  `xml:"description,omitempty" json:"description,omitempty"`
+	Id          int64   `xml:"id,omitempty" json:"id,omitempty"`
+	Mealprice   float64 `xml:"mealprice,omitempty" json:"mealprice,omitempty"`
+	Neteffect   float64 `xml:"neteffect,omitempty" json:"neteffect,omitempty"`
+	Time        int32   `xml:"time,omitempty" json:"time,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *LunchTransactionVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain LunchTransactionVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type NotInSessionDayVO struct {
+	XMLName xml.Name `xml:"notInSessionDays" json:"-"`
+
+	CalType      string `xml:"calType,omitempty" json:"calType,omitempty"`
+	CalendarDay  string `xml:"calendarDay,omitempty" json:"calendarDay,omitempty"`
+	Description  string `xml:"description,omitempty" json:"description,omitempty"`
+	Id           int64  `xml:"id,omitempty" json:"id,omitempty"`
+	SchoolNumber int64  `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *NotInSessionDayVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain NotInSessionDayVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type NotificationSettingsVO struct {
+	XMLName xml.Name `json:"-"`
+
+	ApplyToAllStudents  bool     `xml:"applyToAllStudents,omitempty" json:"applyToAllStudents,omitempty"`
+	BalanceAlerts       bool     `xml:"balanceAlerts,omitempty" json:"balanceAlerts,omitempty"`
+	DetailedAssignments bool     `xml:"detailedAssignments,omitempty" json:"detailedAssignments,omitempty"`
+	DetailedAttendance  bool     `xml:"detailedAttendance,omitempty" json:"detailedAttendance,omitempty"`
+	EmailAddresses      []string `xml:"emailAddresses,omitempty" json:"emailAddresses,omitempty"`
+	Frequency           int32    `xml:"frequency,omitempty" json:"frequency,omitempty"`
+	GradeAndAttSummary  bool     `xml:"gradeAndAttSummary,omitempty" json:"gradeAndAttSummary,omitempty"`
+	GuardianStudentId   int64    `xml:"guardianStudentId,omitempty" json:"guardianStudentId,omitempty"`
+	MainEmail           string   `xml:"mainEmail,omitempty" json:"mainEmail,omitempty"`
+	SchoolAnnouncements bool     `xml:"schoolAnnouncements,omitempty" json:"schoolAnnouncements,omitempty"`
+	SendNow             bool     `xml:"sendNow,omitempty" json:"sendNow,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *NotificationSettingsVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain NotificationSettingsVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type PeriodVO struct {
+	XMLName xml.Name `xml:"periods" json:"-"`
+
+	Abbreviation string `xml:"abbreviation,omitempty" json:"abbreviation,omitempty"`
+	Id           int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Name         string `xml:"name,omitempty" json:"name,omitempty"`
+	PeriodNumber int32  `xml:"periodNumber,omitempty" json:"periodNumber,omitempty"`
+	Schoolid     int64  `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	SortOrder    int32  `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	Yearid       int32  `xml:"yearid,omitempty" json:"yearid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *PeriodVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain PeriodVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+// SchoolVO is listed in both StudentDataVO.Schools and RemoteSchools, so it is
+// decoded from elements of either name and sent under the name of the field
+// holding it.
+type SchoolVO struct {
+	XMLName xml.Name `json:"-"`
+
+	Abbreviation          string              `xml:"abbreviation,omitempty" json:"abbreviation,omitempty"`
+	Address               string              `xml:"address,omitempty" json:"address,omitempty"`
+	DisabledFeatures      *DisabledFeaturesVO `xml:"disabledFeatures,omitempty" json:"disabledFeatures,omitempty"`
+	HighGrade             int32               `xml:"highGrade,omitempty" json:"highGrade,omitempty"`
+	LowGrade              int32               `xml:"lowGrade,omitempty" json:"lowGrade,omitempty"`
+	MapMimeType           string              `xml:"mapMimeType,omitempty" json:"mapMimeType,omitempty"`
+	Name                  string              `xml:"name,omitempty" json:"name,omitempty"`
+	SchoolDisabled        bool                `xml:"schoolDisabled,omitempty" json:"schoolDisabled,omitempty"`
+	SchoolDisabledMessage string              `xml:"schoolDisabledMessage,omitempty" json:"schoolDisabledMessage,omitempty"`
+	SchoolDisabledTitle   string              `xml:"schoolDisabledTitle,omitempty" json:"schoolDisabledTitle,omitempty"`
+	SchoolId              int64               `xml:"schoolId,omitempty" json:"schoolId,omitempty"`
+	SchoolMapModifiedDate string              `xml:"schoolMapModifiedDate,omitempty" json:"schoolMapModifiedDate,omitempty"`
+	SchoolNumber          int64               `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+	Schooladdress         string              `xml:"schooladdress,omitempty" json:"schooladdress,omitempty"`
+	Schoolcity            string              `xml:"schoolcity,omitempty" json:"schoolcity,omitempty"`
+	Schoolcountry         string              `xml:"schoolcountry,omitempty" json:"schoolcountry,omitempty"`
+	Schoolfax             string              `xml:"schoolfax,omitempty" json:"schoolfax,omitempty"`
+	Schoolphone           string              `xml:"schoolphone,omitempty" json:"schoolphone,omitempty"`
+	Schoolstate           string              `xml:"schoolstate,omitempty" json:"schoolstate,omitempty"`
+	Schoolzip             string              `xml:"schoolzip,omitempty" json:"schoolzip,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *SchoolVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain SchoolVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type DisabledFeaturesVO struct {
+	XMLName xml.Name `xml:"disabledFeatures" json:"-"`
+
+	Activities  bool `xml:"activities,omitempty" json:"activities,omitempty"`
+	Assignments bool `xml:"assignments,omitempty" json:"assignments,omitempty"`
+	Attendance  bool `xml:"attendance,omitempty" json:"attendance,omitempty"`
+	Citizenship bool `xml:"citizenship,omitempty" json:"citizenship,omitempty"`
+	CurrentGpa  bool `xml:"currentGpa,omitempty" json:"currentGpa,omitempty"`
+	Emailalerts bool `xml:"emailalerts,omitempty" json:"emailalerts,omitempty"`
+	Fees        bool `xml:"fees,omitempty" json:"fees,omitempty"`
+	FinalGrades bool `xml:"finalGrades,omitempty" json:"finalGrades,omitempty"`
+	Meals       bool `xml:"meals,omitempty" json:"meals,omitempty"`
+	Standards   bool `xml:"standards,omitempty" json:"standards,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *DisabledFeaturesVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain DisabledFeaturesVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type ReportingTermVO struct {
+	XMLName xml.Name `xml:"reportingTerms" json:"-"`
+
+	Abbreviation     string    `xml:"abbreviation,omitempty" json:"abbreviation,omitempty"`
+	EndDate          time.Time `xml:"endDate,omitempty" json:"endDate,omitempty"`
+	Id               int64     `xml:"id,omitempty" json:"id,omitempty"`
+	Schoolid         int64     `xml:"schoolid,omitempty" json:"schoolid,omitempty"`
+	SendingGrades    bool      `xml:"sendingGrades,omitempty" json:"sendingGrades,omitempty"`
+	SortOrder        int32     `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	StartDate        time.Time `xml:"startDate,omitempty" json:"startDate,omitempty"`
+	SuppressGrades   bool      `xml:"suppressGrades,omitempty" json:"suppressGrades,omitempty"`
+	SuppressPercents bool      `xml:"suppressPercents,omitempty" json:"suppressPercents,omitempty"`
+	Termid           int64     `xml:"termid,omitempty" json:"termid,omitempty"`
+	Title            string    `xml:"title,omitempty" json:"title,omitempty"`
+	Yearid           int64     `xml:"yearid,omitempty" json:"yearid,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *ReportingTermVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain ReportingTermVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type SectionVO struct {
+	XMLName xml.Name `xml:"sections" json:"-"`
+
+	CourseCode        string                 `xml:"courseCode,omitempty" json:"courseCode,omitempty"`
+	Dcid              int64                  `xml:"dcid,omitempty" json:"dcid,omitempty"`
+	Description       string                 `xml:"description,omitempty" json:"description,omitempty"`
+	Enrollments       []*SectionEnrollmentVO `xml:"enrollments,omitempty" json:"enrollments,omitempty"`
+	Expression        string                 `xml:"expression,omitempty" json:"expression,omitempty"`
+	GradeBookType     int32                  `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	Id                int64                  `xml:"id,omitempty" json:"id,omitempty"`
+	PeriodSort        int32                  `xml:"periodSort,omitempty" json:"periodSort,omitempty"`
+	RoomName          string                 `xml:"roomName,omitempty" json:"roomName,omitempty"`
+	SchoolCourseTitle string                 `xml:"schoolCourseTitle,omitempty" json:"schoolCourseTitle,omitempty"`
+	SchoolNumber      int64                  `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+	SectionNum        string                 `xml:"sectionNum,omitempty" json:"sectionNum,omitempty"`
+	StartStopDates    []*StartStopDateVO     `xml:"startStopDates,omitempty" json:"startStopDates,omitempty"`
+	TeacherID         int64                  `xml:"teacherID,omitempty" json:"teacherID,omitempty"`
+	TermID            int64                  `xml:"termID,omitempty" json:"termID,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *SectionVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain SectionVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type StartStopDateVO struct {
+	XMLName xml.Name `xml:"startStopDates" json:"-"`
+
+	SectionEnrollmentId int64  `xml:"sectionEnrollmentId,omitempty" json:"sectionEnrollmentId,omitempty"`
+	Start               string `xml:"start,omitempty" json:"start,omitempty"`
+	Stop                string `xml:"stop,omitempty" json:"stop,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *StartStopDateVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain StartStopDateVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type StandardVO struct {
+	XMLName xml.Name `xml:"standards" json:"-"`
+
+	Description      string `xml:"description,omitempty" json:"description,omitempty"`
+	GradeBookType    int32  `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	GradeScaleID     int64  `xml:"gradeScaleID,omitempty" json:"gradeScaleID,omitempty"`
+	Id               int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Identifier       string `xml:"identifier,omitempty" json:"identifier,omitempty"`
+	Name             string `xml:"name,omitempty" json:"name,omitempty"`
+	ParentStandardID int64  `xml:"parentStandardID,omitempty" json:"parentStandardID,omitempty"`
+	SortOrder        int32  `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *StandardVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain StandardVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type StandardGradeVO struct {
+	XMLName xml.Name `xml:"standardsGrades" json:"-"`
+
+	Comment            string `xml:"comment,omitempty" json:"comment,omitempty"`
+	CommentLastUpdated string `xml:"commentLastUpdated,omitempty" json:"commentLastUpdated,omitempty"`
+	Exempt             int32  `xml:"exempt,omitempty" json:"exempt,omitempty"`
+	GradeBookType      int32  `xml:"gradeBookType,omitempty" json:"gradeBookType,omitempty"`
+	GradeEntered       string `xml:"gradeEntered,omitempty" json:"gradeEntered,omitempty"`
+	GradeLastUpdated   string `xml:"gradeLastUpdated,omitempty" json:"gradeLastUpdated,omitempty"`
+	GradeType          int32  `xml:"gradeType,omitempty" json:"gradeType,omitempty"`
+	Id                 int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Late               int32  `xml:"late,omitempty" json:"late,omitempty"`
+	Missing            int32  `xml:"missing,omitempty" json:"missing,omitempty"`
+	ReportingTermId    int64  `xml:"reportingTermId,omitempty" json:"reportingTermId,omitempty"`
+	SectionDcid        int64  `xml:"sectionDcid,omitempty" json:"sectionDcid,omitempty"`
+	SectionId          int64  `xml:"sectionId,omitempty" json:"sectionId,omitempty"`
+	StandardId         int64  `xml:"standardId,omitempty" json:"standardId,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *StandardGradeVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain StandardGradeVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type StudentVO struct {
+	XMLName xml.Name `xml:"student" json:"-"`
+
+	CurrentGPA             string  `xml:"currentGPA,omitempty" json:"currentGPA,omitempty"`
+	CurrentMealBalance     float64 `xml:"currentMealBalance,omitempty" json:"currentMealBalance,omitempty"`
+	CurrentTerm            string  `xml:"currentTerm,omitempty" json:"currentTerm,omitempty"`
+	Dcid                   int64   `xml:"dcid,omitempty" json:"dcid,omitempty"`
+	Dob                    string  `xml:"dob,omitempty" json:"dob,omitempty"`
+	Ethnicity              string  `xml:"ethnicity,omitempty" json:"ethnicity,omitempty"`
+	FirstName              string  `xml:"firstName,omitempty" json:"firstName,omitempty"`
+	Gender                 string  `xml:"gender,omitempty" json:"gender,omitempty"`
+	GradeLevel             int32   `xml:"gradeLevel,omitempty" json:"gradeLevel,omitempty"`
+	GuardianAccessDisabled bool    `xml:"guardianAccessDisabled,omitempty" json:"guardianAccessDisabled,omitempty"`
+	Id                     int64   `xml:"id,omitempty" json:"id,omitempty"`
+	LastName               string  `xml:"lastName,omitempty" json:"lastName,omitempty"`
+	MiddleName             string  `xml:"middleName,omitempty" json:"middleName,omitempty"`
+	PhotoDate              string  `xml:"photoDate,omitempty" json:"photoDate,omitempty"`
+	StartingMealBalance    float64 `xml:"startingMealBalance,omitempty" json:"startingMealBalance,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *StudentVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain StudentVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type TeacherVO struct {
+	XMLName xml.Name `xml:"teachers" json:"-"`
+
+	Email       string `xml:"email,omitempty" json:"email,omitempty"`
+	FirstName   string `xml:"firstName,omitempty" json:"firstName,omitempty"`
+	Id          int64  `xml:"id,omitempty" json:"id,omitempty"`
+	LastName    string `xml:"lastName,omitempty" json:"lastName,omitempty"`
+	SchoolPhone string `xml:"schoolPhone,omitempty" json:"schoolPhone,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *TeacherVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain TeacherVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type TermVO struct {
+	XMLName xml.Name `xml:"terms" json:"-"`
+
+	Abbrev       string `xml:"abbrev,omitempty" json:"abbrev,omitempty"`
+	EndDate      string `xml:"endDate,omitempty" json:"endDate,omitempty"`
+	Id           int64  `xml:"id,omitempty" json:"id,omitempty"`
+	ParentTermId int64  `xml:"parentTermId,omitempty" json:"parentTermId,omitempty"`
+	SchoolNumber string `xml:"schoolNumber,omitempty" json:"schoolNumber,omitempty"`
+	StartDate    string `xml:"startDate,omitempty" json:"startDate,omitempty"`
+	Title        string `xml:"title,omitempty" json:"title,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *TermVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain TermVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type UserSessionVO struct {
+	XMLName xml.Name `xml:"userSessionVO" json:"-"`
+
+	Locale            *Locale     `xml:"locale,omitempty" json:"locale,omitempty"`
+	ServerCurrentTime string      `xml:"serverCurrentTime,omitempty" json:"serverCurrentTime,omitempty"`
+	ServerInfo        *ServerInfo `xml:"serverInfo,omitempty" json:"serverInfo,omitempty"`
+	ServiceTicket     string      `xml:"serviceTicket,omitempty" json:"serviceTicket,omitempty"`
+	StudentIDs        []int32     `xml:"studentIDs,omitempty" json:"studentIDs,omitempty"`
+	UserId            int64       `xml:"userId,omitempty" json:"userId,omitempty"`
+	UserType          int32       `xml:"userType,omitempty" json:"userType,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *UserSessionVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain UserSessionVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type QueryIncludeListVO struct {
+	XMLName xml.Name `xml:"http://vo.rest.powerschool.pearson.com/xsd qil" json:"-"`
+
+	Includes []int32 `xml:"includes,omitempty" json:"includes,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *QueryIncludeListVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain QueryIncludeListVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type PasswordResetVO struct {
+	XMLName xml.Name `xml:"return" json:"-"`
+
+	*BaseResultsVO
+
+	MinPasswordLength int32  `xml:"minPasswordLength,omitempty" json:"minPasswordLength,omitempty"`
+	ServiceTicket     string `xml:"serviceTicket,omitempty" json:"serviceTicket,omitempty"`
+	Successful        bool   `xml:"successful,omitempty" json:"successful,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *PasswordResetVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain PasswordResetVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type CredentialComplexityRulesVO struct {
+	XMLName xml.Name `xml:"return" json:"-"`
+
+	*BaseResultsVO
+
+	LettersAndNumRequired    bool  `xml:"lettersAndNumRequired,omitempty" json:"lettersAndNumRequired,omitempty"`
+	MixOfCaseRequired        bool  `xml:"mixOfCaseRequired,omitempty" json:"mixOfCaseRequired,omitempty"`
+	RequiredCharacterCount   int32 `xml:"requiredCharacterCount,omitempty" json:"requiredCharacterCount,omitempty"`
+	SpecialCharacterRequired bool  `xml:"specialCharacterRequired,omitempty" json:"specialCharacterRequired,omitempty"`
+	Successful               bool  `xml:"successful,omitempty" json:"successful,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *CredentialComplexityRulesVO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain CredentialComplexityRulesVO
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type BulletinLite struct {
+	XMLName xml.Name `xml:"bulletins" json:"-"`
+
+	Audience  int64  `xml:"audience,omitempty" json:"audience,omitempty"`
+	Body      string `xml:"body,omitempty" json:"body,omitempty"`
+	EndDate   string `xml:"endDate,omitempty" json:"endDate,omitempty"`
+	Id        int64  `xml:"id,omitempty" json:"id,omitempty"`
+	Name      string `xml:"name,omitempty" json:"name,omitempty"`
+	SchoolId  int64  `xml:"schoolId,omitempty" json:"schoolId,omitempty"`
+	SortOrder int32  `xml:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	StartDate string `xml:"startDate,omitempty" json:"startDate,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *BulletinLite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain BulletinLite
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+type ServerInfo struct {
+	XMLName xml.Name `xml:"serverInfo" json:"-"`
+
+	ApiVersion                  string `xml:"apiVersion,omitempty" json:"apiVersion,omitempty"`
+	DayLightSavings             int32  `xml:"dayLightSavings,omitempty" json:"dayLightSavings,omitempty"`
+	ParentSAMLEndPoint          string `xml:"parentSAMLEndPoint,omitempty" json:"parentSAMLEndPoint,omitempty"`
+	PublicPortalDisabled        bool   `xml:"publicPortalDisabled,omitempty" json:"publicPortalDisabled,omitempty"`
+	PublicPortalDisabledMessage string `xml:"publicPortalDisabledMessage,omitempty" json:"publicPortalDisabledMessage,omitempty"`
+	RawOffset                   int32  `xml:"rawOffset,omitempty" json:"rawOffset,omitempty"`
+	ServerTime                  string `xml:"serverTime,omitempty" json:"serverTime,omitempty"`
+	StudentSAMLEndPoint         string `xml:"studentSAMLEndPoint,omitempty" json:"studentSAMLEndPoint,omitempty"`
+	TeacherSAMLEndPoint         string `xml:"teacherSAMLEndPoint,omitempty" json:"teacherSAMLEndPoint,omitempty"`
+	TimeZoneName                string `xml:"timeZoneName,omitempty" json:"timeZoneName,omitempty"`
+
+	Extra []RawElement `xml:",any" json:"-"`
+}
+
+// MarshalXML sends v under the name of the field holding it, without
+// the unknown elements it was decoded with.
+func (v *ServerInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type plain ServerInfo
+	sent := plain(*v)
+	sent.Extra = nil
+	return e.EncodeElement(&sent, start)
+}
+
+// Aliases for types generated under other names by earlier versions.
+type (
+	ExSet = Set
+	UASet = Set
+	ULSet = Set
+)
+
+// PublicPortalServiceJSONPortType is safe for concurrent use by multiple
+// goroutines; share one per server rather than creating one per call.
+type PublicPortalServiceJSONPortType struct {
+	client Transport
+}
+
+func NewPublicPortalServiceJSONPortType(url string, tls bool, auth *DigestAuth) *PublicPortalServiceJSONPortType {
+	client := NewSOAPClient(url, tls, auth)
+
+	return &PublicPortalServiceJSONPortType{
+		client: client,
+	}
+}
+
+func (service *PublicPortalServiceJSONPortType) GetCredentialComplexityRules(request *GetCredentialComplexityRules) (*GetCredentialComplexityRulesResponse, error) {
+	response := new(GetCredentialComplexityRulesResponse)
+	err := service.client.Call("urn:getCredentialComplexityRules", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) LogoutAndDelinkDeviceToken(request *LogoutAndDelinkDeviceToken) (*LogoutAndDelinkDeviceTokenResponse, error) {
+	response := new(LogoutAndDelinkDeviceTokenResponse)
+	err := service.client.Call("urn:logoutAndDelinkDeviceToken", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) GetStudentData(request *GetStudentData) (*GetStudentDataResponse, error) {
+	response := new(GetStudentDataResponse)
+	err := service.client.Call("urn:getStudentData", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) Login(request *Login) (*LoginResponse, error) {
+	response := new(LoginResponse)
+	err := service.client.Call("urn:login", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) SendPasswordRecoveryEmail(request *SendPasswordRecoveryEmail) (*SendPasswordRecoveryEmailResponse, error) {
+	response := new(SendPasswordRecoveryEmailResponse)
+	err := service.client.Call("urn:sendPasswordRecoveryEmail", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) Logout(request *Logout) (*LogoutResponse, error) {
+	response := new(LogoutResponse)
+	err := service.client.Call("urn:logout", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) LoginToPublicPortal(request *LoginToPublicPortal) (*LoginToPublicPortalResponse, error) {
+	response := new(LoginToPublicPortalResponse)
+	err := service.client.Call("urn:loginToPublicPortal", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) RecoverUsername(request *RecoverUsername) (*RecoverUsernameResponse, error) {
+	response := new(RecoverUsernameResponse)
+	err := service.client.Call("urn:recoverUsername", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) LinkDeviceTokenToUser(request *LinkDeviceTokenToUser) (*LinkDeviceTokenToUserResponse, error) {
+	response := new(LinkDeviceTokenToUserResponse)
+	err := service.client.Call("urn:linkDeviceTokenToUser", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) GetStudentPhoto(request *GetStudentPhoto) (*GetStudentPhotoResponse, error) {
+	response := new(GetStudentPhotoResponse)
+	err := service.client.Call("urn:getStudentPhoto", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) RecoverPassword(request *RecoverPassword) (*RecoverPasswordResponse, error) {
+	response := new(RecoverPasswordResponse)
+	err := service.client.Call("urn:recoverPassword", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) GetSchoolMapBySchoolNumber(request *GetSchoolMapBySchoolNumber) (*GetSchoolMapBySchoolNumberResponse, error) {
+	response := new(GetSchoolMapBySchoolNumberResponse)
+	err := service.client.Call("urn:getSchoolMapBySchoolNumber", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) StoreNotificationSettings(request *StoreNotificationSettings) (*StoreNotificationSettingsResponse, error) {
+	response := new(StoreNotificationSettingsResponse)
+	err := service.client.Call("urn:storeNotificationSettings", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) StoreCourseRequests(request *StoreCourseRequests) (*StoreCourseRequestsResponse, error) {
+	response := new(StoreCourseRequestsResponse)
+	err := service.client.Call("urn:storeCourseRequests", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) GetAllCourseRequests(request *GetAllCourseRequests) (*GetAllCourseRequestsResponse, error) {
+	response := new(GetAllCourseRequestsResponse)
+	err := service.client.Call("urn:getAllCourseRequests", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (service *PublicPortalServiceJSONPortType) GetStartStopTimeForAllSections(request *GetStartStopTimeForAllSections) (*GetStartStopTimeForAllSectionsResponse, error) {
+	response := new(GetStartStopTimeForAllSectionsResponse)
+	err := service.client.Call("urn:getStartStopTimeForAllSections", request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,91 @@
+package gopowerschool
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	soap11Namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Namespace = "http://www.w3.org/2003/05/soap-envelope"
+
+	wssePasswordTextType = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText"
+)
+
+// SOAPVersion selects the envelope format.
+type SOAPVersion int
+
+const (
+	// SOAP11 is the default.
+	SOAP11 SOAPVersion = iota
+	SOAP12
+)
+
+func (v SOAPVersion) namespace() string {
+	if v == SOAP12 {
+		return soap12Namespace
+	}
+	return soap11Namespace
+}
+
+// WithSOAPVersion sends SOAP 1.2 envelopes instead of SOAP 1.1. Faults of
+// either version are understood regardless.
+func WithSOAPVersion(version SOAPVersion) ClientOption {
+	return func(c *clientConfig) error {
+		c.soapVersion = version
+		return nil
+	}
+}
+
+// WithSOAPHeaders adds headers to the envelope of every call. Each header is
+// encoded with encoding/xml and should carry its own XMLName.
+func WithSOAPHeaders(headers ...interface{}) ClientOption {
+	return func(c *clientConfig) error {
+		c.soapHeaders = append(c.soapHeaders, headers...)
+		return nil
+	}
+}
+
+// WithSOAPHeaderFunc adds the headers returned by fn, called once per call,
+// after any given to WithSOAPHeaders. Use it for per-call tracking headers.
+func WithSOAPHeaderFunc(fn func(ctx context.Context, soapAction string) []interface{}) ClientOption {
+	return func(c *clientConfig) error {
+		c.soapHeaderFunc = fn
+		return nil
+	}
+}
+
+// WSSecurity is a WS-Security header.
+type WSSecurity struct {
+	XMLName xml.Name `xml:"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd Security"`
+
+	UsernameToken *WSSUsernameToken `xml:"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd UsernameToken,omitempty"`
+}
+
+type WSSUsernameToken struct {
+	Username string      `xml:"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd Username"`
+	Password WSSPassword `xml:"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd Password"`
+}
+
+type WSSPassword struct {
+	Type  string `xml:"Type,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// NewWSSecurityUsernameToken returns a WS-Security header carrying a
+// UsernameToken with a plain-text password, for WithSOAPHeaders.
+func NewWSSecurityUsernameToken(username, password string) *WSSecurity {
+	return &WSSecurity{
+		UsernameToken: &WSSUsernameToken{
+			Username: username,
+			Password: WSSPassword{Type: wssePasswordTextType, Value: password},
+		},
+	}
+}
+
+func (w WSSecurity) String() string {
+	if w.UsernameToken == nil {
+		return "{}"
+	}
+	return "{UsernameToken:{Username:" + w.UsernameToken.Username + " Password:" + redacted + "}}"
+}
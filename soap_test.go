@@ -0,0 +1,164 @@
+package gopowerschool
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type traceHeader struct {
+	XMLName xml.Name `xml:"urn:trace Trace"`
+	ID      string   `xml:"id"`
+}
+
+// The envelopes sent for each SOAP version and header option, byte for byte.
+func TestGoldenEnvelopes(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		options     []ClientOption
+		contentType string
+		soapAction  string
+		envelope    string
+	}{
+		{
+			name:        "SOAP 1.1",
+			contentType: `text/xml; charset="utf-8"`,
+			soapAction:  "urn:getCredentialComplexityRules",
+			envelope: `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+				`<getCredentialComplexityRules xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userType>2</userType></getCredentialComplexityRules>` +
+				`</Body></Envelope>`,
+		},
+		{
+			name:        "SOAP 1.2",
+			options:     []ClientOption{WithSOAPVersion(SOAP12)},
+			contentType: `application/soap+xml; charset=utf-8; action="urn:getCredentialComplexityRules"`,
+			envelope: `<Envelope xmlns="http://www.w3.org/2003/05/soap-envelope"><Body xmlns="http://www.w3.org/2003/05/soap-envelope">` +
+				`<getCredentialComplexityRules xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userType>2</userType></getCredentialComplexityRules>` +
+				`</Body></Envelope>`,
+		},
+		{
+			name: "WS-Security",
+			options: []ClientOption{
+				WithSOAPHeaders(NewWSSecurityUsernameToken("student", "pa<ss")),
+				WithSOAPHeaderFunc(func(ctx context.Context, soapAction string) []interface{} {
+					return []interface{}{traceHeader{ID: soapAction}}
+				}),
+			},
+			contentType: `text/xml; charset="utf-8"`,
+			soapAction:  "urn:getCredentialComplexityRules",
+			envelope: `<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><Header xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+				`<Security xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd">` +
+				`<UsernameToken xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd">` +
+				`<Username xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd">student</Username>` +
+				`<Password xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd" ` +
+				`Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">pa&lt;ss</Password>` +
+				`</UsernameToken></Security><Trace xmlns="urn:trace"><id>urn:getCredentialComplexityRules</id></Trace></Header>` +
+				`<Body xmlns="http://schemas.xmlsoap.org/soap/envelope/">` +
+				`<getCredentialComplexityRules xmlns="http://publicportal.rest.powerschool.pearson.com/xsd"><userType>2</userType></getCredentialComplexityRules>` +
+				`</Body></Envelope>`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+				if got := r.Header.Get("Content-Type"); got != test.contentType {
+					t.Errorf("Content-Type = %s, want %s", got, test.contentType)
+				}
+				if got := r.Header.Get("SOAPAction"); got != test.soapAction {
+					t.Errorf("SOAPAction = %q, want %q", got, test.soapAction)
+				}
+				if string(body) != test.envelope {
+					t.Errorf("envelope\n%s\nwant\n%s", body, test.envelope)
+				}
+				io.WriteString(w, soapResponse("getCredentialComplexityRules", ""))
+			}))
+			defer server.Close()
+			client, err := NewClient(server.URL, test.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := callRules(client); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestFaultDecoding(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		options  []ClientOption
+		reply    string
+		fault    SOAPFault
+		message  string
+		subcodes []string
+	}{
+		{
+			name: "SOAP 1.1",
+			reply: `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><soapenv:Fault>` +
+				`<faultcode>soapenv:Client</faultcode><faultstring>Invalid ticket</faultstring><faultactor>urn:portal</faultactor>` +
+				`<detail>ticket expired</detail></soapenv:Fault></soapenv:Body></soapenv:Envelope>`,
+			fault:   SOAPFault{Code: "soapenv:Client", String: "Invalid ticket", Actor: "urn:portal", Detail: "ticket expired"},
+			message: "Invalid ticket",
+		},
+		{
+			name:    "SOAP 1.2 with subcodes",
+			options: []ClientOption{WithSOAPVersion(SOAP12)},
+			reply: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body><env:Fault>` +
+				`<env:Code><env:Value>env:Sender</env:Value><env:Subcode><env:Value>ps:InvalidTicket</env:Value>` +
+				`<env:Subcode><env:Value>ps:Expired</env:Value></env:Subcode></env:Subcode></env:Code>` +
+				`<env:Reason><env:Text xml:lang="en">Ticket expired</env:Text><env:Text xml:lang="es">Boleto vencido</env:Text></env:Reason>` +
+				`<env:Node>urn:node</env:Node><env:Role>urn:role</env:Role>` +
+				`<env:Detail><ps:ticket xmlns:ps="urn:ps">abc</ps:ticket></env:Detail></env:Fault></env:Body></env:Envelope>`,
+			fault: SOAPFault{
+				FaultCode:   &SOAPFaultCode{Value: "env:Sender", Subcode: &SOAPFaultCode{Value: "ps:InvalidTicket", Subcode: &SOAPFaultCode{Value: "ps:Expired"}}},
+				Reason:      []SOAPFaultReason{{Lang: "en", Text: "Ticket expired"}, {Lang: "es", Text: "Boleto vencido"}},
+				Node:        "urn:node",
+				Role:        "urn:role",
+				FaultDetail: &SOAPFaultDetail{Content: `<ps:ticket xmlns:ps="urn:ps">abc</ps:ticket>`},
+			},
+			message:  "Ticket expired",
+			subcodes: []string{"ps:InvalidTicket", "ps:Expired"},
+		},
+		{
+			// SOAP 1.2 faults are understood by a SOAP 1.1 client, and
+			// fall back to their code without a reason.
+			name: "SOAP 1.2 without a reason",
+			reply: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body><env:Fault>` +
+				`<env:Code><env:Value>env:Receiver</env:Value></env:Code></env:Fault></env:Body></env:Envelope>`,
+			fault:   SOAPFault{FaultCode: &SOAPFaultCode{Value: "env:Receiver"}},
+			message: "env:Receiver",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, test.reply)
+			}))
+			defer server.Close()
+			client, err := NewClient(server.URL, test.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var fault *SOAPFault
+			if err := callRules(client); !errors.As(err, &fault) {
+				t.Fatalf("call error = %v, want a *SOAPFault", err)
+			}
+			fault.XMLName = xml.Name{}
+			if !reflect.DeepEqual(*fault, test.fault) {
+				t.Errorf("fault = %+v, want %+v", *fault, test.fault)
+			}
+			if fault.Error() != test.message {
+				t.Errorf("Error() = %q, want %q", fault.Error(), test.message)
+			}
+			if subcodes := fault.Subcodes(); !reflect.DeepEqual(subcodes, test.subcodes) {
+				t.Errorf("Subcodes() = %q, want %q", subcodes, test.subcodes)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package gopowerschool
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// StreamHandlers maps element local names, such as "attendance" or
+// "assignments", to callbacks. While a response is decoded, every element
+// with a handled name is decoded into a new value of its field's type and
+// passed to the callback as a pointer (an *AttendanceVO for "attendance")
+// instead of being kept in the response. A handler error stops the call.
+type StreamHandlers map[string]func(value interface{}) error
+
+// CallStream is like CallContext, but hands elements named in handlers to
+// their callbacks as they are parsed, so large lists are never held in memory
+// at once. Calls with handlers are not retried, since handlers may already
+// have seen part of a response.
+func (s *SOAPClient) CallStream(ctx context.Context, soapAction string, request, response interface{}, handlers StreamHandlers) error {
+	return s.call(ctx, soapAction, request, response, handlers)
+}
+
+// GetStudentDataStream is GetStudentData with handlers for the lists inside
+// each StudentDataVO, for example:
+//
+//	handlers := StreamHandlers{"attendance": func(v interface{}) error {
+//		return export(v.(*AttendanceVO))
+//	}}
+func (service *PublicPortalServiceJSONPortType) GetStudentDataStream(ctx context.Context, request *GetStudentData, handlers StreamHandlers) (*GetStudentDataResponse, error) {
+	streamer, ok := service.client.(streamTransport)
+	if !ok {
+		return nil, errors.New("transport does not support streaming")
+	}
+	response := new(GetStudentDataResponse)
+	err := streamer.CallStream(ctx, "urn:getStudentData", request, response, handlers)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+type streamTransport interface {
+	CallStream(ctx context.Context, soapAction string, request, response interface{}, handlers StreamHandlers) error
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// decodeResponse decodes a SOAP envelope from r into call.Response and
+// returns the fault it carries, if any. In lenient mode, body elements other
+// than the response are returned instead of failing the call.
+func decodeResponse(r io.Reader, call *SOAPCall, lenient bool) (*SOAPFault, []RawElement, error) {
+	decoder := xml.NewDecoder(r)
+	if call.Handlers == nil {
+		respEnvelope := new(SOAPEnvelope)
+		respEnvelope.Body = SOAPBody{Content: call.Response, lenient: lenient}
+		if err := decoder.Decode(respEnvelope); err != nil {
+			return nil, nil, err
+		}
+		return respEnvelope.Body.Fault, respEnvelope.Body.Extra, nil
+	}
+
+	envelope, err := nextStart(decoder)
+	if err != nil {
+		return nil, nil, err
+	}
+	if envelope.Name.Local != "Envelope" {
+		return nil, nil, fmt.Errorf("expected SOAP Envelope, found <%s>", envelope.Name.Local)
+	}
+	for {
+		start, err := nextStart(decoder)
+		if err != nil {
+			return nil, nil, err
+		}
+		if start.Name.Local != "Body" {
+			if err := decoder.Skip(); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		body := SOAPBody{Content: call.Response, lenient: lenient}
+		var fault *SOAPFault
+		consumed := false
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			content, ok := token.(xml.StartElement)
+			if !ok {
+				if _, end := token.(xml.EndElement); end {
+					return fault, body.Extra, nil
+				}
+				continue
+			}
+			if lenient && (consumed || !body.expects(content.Name)) {
+				var extra RawElement
+				if err := decoder.DecodeElement(&extra, &content); err != nil {
+					return nil, nil, err
+				}
+				body.Extra = append(body.Extra, extra)
+				continue
+			}
+			if isFault(content.Name) {
+				fault = new(SOAPFault)
+				if err := decoder.DecodeElement(fault, &content); err != nil {
+					return nil, nil, err
+				}
+			} else {
+				target := reflect.ValueOf(call.Response)
+				if target.Kind() != reflect.Ptr || target.IsNil() {
+					return nil, nil, xml.UnmarshalError("Content must be a pointer to a struct")
+				}
+				if err := streamElement(decoder, content, target.Elem(), call.Handlers); err != nil {
+					return nil, nil, err
+				}
+			}
+			if !lenient {
+				return fault, nil, nil
+			}
+			consumed = true
+		}
+	}
+}
+
+func nextStart(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			return t, nil
+		case xml.EndElement:
+			return xml.StartElement{}, xml.UnmarshalError("unexpected end of element </" + t.Name.Local + ">")
+		}
+	}
+}
+
+// streamElement decodes the element opened by start into the struct v,
+// diverting handled children to their callbacks.
+func streamElement(d *xml.Decoder, start xml.StartElement, v reflect.Value, handlers StreamHandlers) error {
+	if v.Kind() != reflect.Struct {
+		return d.DecodeElement(v.Addr().Interface(), &start)
+	}
+	fields := xmlFields(v.Type())
+	if name := v.FieldByName("XMLName"); name.IsValid() && name.Type() == reflect.TypeOf(xml.Name{}) {
+		name.Set(reflect.ValueOf(start.Name))
+	}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			index, known := fields[t.Name.Local]
+			if !known {
+				if index, ok := fields[anyField]; ok {
+					field := fieldByIndexAlloc(v, index)
+					var extra RawElement
+					if err := d.DecodeElement(&extra, &t); err != nil {
+						return err
+					}
+					field.Set(reflect.Append(field, reflect.ValueOf(extra)))
+					continue
+				}
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			field := fieldByIndexAlloc(v, index)
+			if handler, ok := handlers[t.Name.Local]; ok {
+				value := reflect.New(baseType(field.Type()))
+				if err := d.DecodeElement(value.Interface(), &t); err != nil {
+					return err
+				}
+				if err := handler(value.Interface()); err != nil {
+					return err
+				}
+				continue
+			}
+			if !streams(baseType(field.Type()), handlers, map[reflect.Type]bool{}) {
+				if err := d.DecodeElement(field.Addr().Interface(), &t); err != nil {
+					return err
+				}
+				continue
+			}
+			switch field.Kind() {
+			case reflect.Slice:
+				elem := reflect.New(field.Type().Elem()).Elem()
+				if err := streamElement(d, t, indirectAlloc(elem), handlers); err != nil {
+					return err
+				}
+				field.Set(reflect.Append(field, elem))
+			default:
+				if err := streamElement(d, t, indirectAlloc(field), handlers); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// anyField is the xmlFields key of a struct's ",any" field. It cannot clash
+// with an element name.
+const anyField = ",any"
+
+// xmlFields maps the element names of a struct's fields, including those of
+// embedded structs, to their indexes. A ",any" field is listed as anyField.
+func xmlFields(t reflect.Type) map[string][]int {
+	fields := map[string][]int{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Name == "XMLName" {
+			continue
+		}
+		tag := f.Tag.Get("xml")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for name, index := range xmlFields(embedded) {
+					if _, ok := fields[name]; !ok {
+						fields[name] = append([]int{i}, index...)
+					}
+				}
+				continue
+			}
+		}
+		if tag == ",any" {
+			fields[anyField] = []int{i}
+			continue
+		}
+		if strings.HasPrefix(tag, ",") {
+			continue
+		}
+		fields[xmlFieldName(f)] = []int{i}
+	}
+	return fields
+}
+
+// streams reports whether decoding a t may reach a handled element.
+func streams(t reflect.Type, handlers StreamHandlers, seen map[reflect.Type]bool) bool {
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+	for name, index := range xmlFields(t) {
+		if _, ok := handlers[name]; ok {
+			return true
+		}
+		if streams(baseType(t.FieldByIndex(index).Type), handlers, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// baseType strips slices (other than []byte) and pointers from t.
+func baseType(t reflect.Type) reflect.Type {
+	for {
+		switch {
+		case t.Kind() == reflect.Ptr:
+			t = t.Elem()
+		case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// indirectAlloc follows pointers from v, allocating nil ones.
+func indirectAlloc(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			v = indirectAlloc(v)
+		}
+		v = v.Field(x)
+	}
+	return v
+}
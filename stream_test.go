@@ -0,0 +1,120 @@
+package gopowerschool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const streamedStudents = `<studentDataVOs><assignments><assignmentid>10</assignmentid></assignments>` +
+	`<attendance><id>1</id></attendance><attendance><id>2</id></attendance></studentDataVOs>` +
+	`<studentDataVOs><attendance><id>3</id></attendance><assignments><assignmentid>11</assignmentid></assignments></studentDataVOs>`
+
+// streamServer answers getStudentData with reply and counts the calls.
+func streamServer(t *testing.T, reply string) (*PublicPortalServiceJSONPortType, *int) {
+	calls := 0
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		calls++
+		io.WriteString(w, reply)
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Retryable: func(error) bool { return true }}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &calls
+}
+
+func TestGetStudentDataStream(t *testing.T) {
+	client, _ := streamServer(t, soapResponse("getStudentData", streamedStudents))
+
+	var ids []int64
+	handlers := StreamHandlers{"attendance": func(v interface{}) error {
+		ids = append(ids, v.(*AttendanceVO).Id)
+		return nil
+	}}
+	response, err := client.GetStudentDataStream(context.Background(), &GetStudentData{StudentIDs: []int64{1, 2}}, handlers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("streamed attendance %v, want [1 2 3]", ids)
+	}
+	students := response.Return_.StudentDataVOs
+	if len(students) != 2 {
+		t.Fatalf("response has %d students, want 2", len(students))
+	}
+	for i, student := range students {
+		if student.Attendance != nil {
+			t.Errorf("student %d kept its streamed attendance", i)
+		}
+		if len(student.Assignments) != 1 || student.Assignments[0].Assignmentid != int64(10+i) {
+			t.Errorf("student %d assignments = %v", i, student.Assignments)
+		}
+	}
+}
+
+// A handler error stops the call, which is not retried since the handler
+// has already seen part of the response.
+func TestGetStudentDataStreamHandlerError(t *testing.T) {
+	client, calls := streamServer(t, soapResponse("getStudentData", streamedStudents))
+	stop := errors.New("stop")
+
+	seen := 0
+	handlers := StreamHandlers{"attendance": func(v interface{}) error {
+		seen++
+		return stop
+	}}
+	if _, err := client.GetStudentDataStream(context.Background(), &GetStudentData{}, handlers); !errors.Is(err, stop) {
+		t.Errorf("GetStudentDataStream() error = %v, want %v", err, stop)
+	}
+	if seen != 1 || *calls != 1 {
+		t.Errorf("handler saw %d elements in %d calls, want 1 in 1", seen, *calls)
+	}
+}
+
+func TestGetStudentDataStreamFaults(t *testing.T) {
+	for _, test := range []struct {
+		name, reply string
+		fault       bool
+	}{
+		{
+			"SOAP 1.1",
+			`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><soapenv:Fault>` +
+				`<faultcode>soapenv:Server</faultcode><faultstring>session expired</faultstring></soapenv:Fault></soapenv:Body></soapenv:Envelope>`,
+			true,
+		},
+		{
+			"SOAP 1.2",
+			`<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body><env:Fault>` +
+				`<env:Code><env:Value>env:Receiver</env:Value></env:Code><env:Reason><env:Text xml:lang="en">session expired</env:Text></env:Reason>` +
+				`</env:Fault></env:Body></env:Envelope>`,
+			true,
+		},
+		{
+			// A Fault element outside the SOAP namespaces is not a fault.
+			"other namespace",
+			`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body>` +
+				`<ns:Fault xmlns:ns="http://publicportal.rest.powerschool.pearson.com/xsd"><faultstring>session expired</faultstring></ns:Fault>` +
+				`</soapenv:Body></soapenv:Envelope>`,
+			false,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			client, _ := streamServer(t, test.reply)
+			handlers := StreamHandlers{"attendance": func(v interface{}) error { return nil }}
+
+			_, err := client.GetStudentDataStream(context.Background(), &GetStudentData{}, handlers)
+			var fault *SOAPFault
+			if isFault := errors.As(err, &fault); isFault != test.fault {
+				t.Fatalf("GetStudentDataStream() error = %v, fault %v", err, test.fault)
+			}
+			if test.fault && fault.Error() != "session expired" {
+				t.Errorf("fault = %q", fault.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package gopowerschool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Attribute is a key/value pair attached to a span.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts spans. It mirrors the subset of the OpenTelemetry tracing API
+// this package needs, so an OpenTelemetry tracer can be adapted to it with a
+// few lines.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a unit of traced work.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Metrics records counters and histograms. MetricsRegistry implements it.
+type Metrics interface {
+	IncCounter(name string, labels map[string]string)
+	ObserveHistogram(name string, value float64, labels map[string]string)
+}
+
+// Names of the metrics recorded by InstrumentationInterceptor.
+const (
+	MetricCalls         = "powerschool_calls_total"
+	MetricErrors        = "powerschool_call_errors_total"
+	MetricCallDuration  = "powerschool_call_duration_seconds"
+	MetricRequestBytes  = "powerschool_request_bytes"
+	MetricResponseBytes = "powerschool_response_bytes"
+)
+
+// Span names used by the client.
+const (
+	SpanCall            = "powerschool.call"
+	SpanDigestChallenge = "powerschool.digest_challenge"
+	SpanSOAPRoundTrip   = "powerschool.soap_round_trip"
+)
+
+// WithTracer traces every call. Each call gets a span with a child span for
+// the digest challenge and one for the SOAP round trip of every attempt.
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *clientConfig) error {
+		c.tracer = tracer
+		return nil
+	}
+}
+
+// WithMetrics records call counts, durations, payload sizes and errors to
+// metrics. Errors are labelled with the code of each error MessageVO the
+// server returned, or with "soap_fault", "http_<status>" or "transport";
+// informational messages, whose msgCode is 0, are not counted.
+func WithMetrics(metrics Metrics) ClientOption {
+	return func(c *clientConfig) error {
+		c.metrics = metrics
+		return nil
+	}
+}
+
+// InstrumentationInterceptor returns the interceptor installed by WithTracer
+// and WithMetrics. Either argument may be nil.
+func InstrumentationInterceptor(tracer Tracer, metrics Metrics) Interceptor {
+	return func(call *SOAPCall, next Invoker) error {
+		var span Span
+		if tracer != nil {
+			attrs := []Attribute{{Key: "soap.action", Value: call.Action}}
+			if version := apiVersion(call.Request); version != "" {
+				attrs = append(attrs, Attribute{Key: "powerschool.api_version", Value: version})
+			}
+			call.Context, span = tracer.Start(call.Context, SpanCall, attrs...)
+		}
+
+		start := time.Now()
+		err := next(call)
+		duration := time.Since(start)
+
+		if span != nil {
+			attrs := []Attribute{{Key: "soap.attempts", Value: call.Attempts}}
+			if call.HTTPResponse != nil {
+				attrs = append(attrs, Attribute{Key: "http.status_code", Value: call.HTTPResponse.StatusCode})
+			}
+			if version := apiVersion(call.Response); version != "" {
+				attrs = append(attrs, Attribute{Key: "powerschool.api_version", Value: version})
+			}
+			span.SetAttributes(attrs...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}
+		if metrics != nil {
+			labels := map[string]string{"action": call.Action}
+			metrics.IncCounter(MetricCalls, labels)
+			metrics.ObserveHistogram(MetricCallDuration, duration.Seconds(), labels)
+			metrics.ObserveHistogram(MetricRequestBytes, float64(len(call.Envelope)), labels)
+			if call.HTTPResponse != nil {
+				metrics.ObserveHistogram(MetricResponseBytes, float64(call.ResponseSize), labels)
+			}
+			for _, message := range responseMessages(call.Response) {
+				if isErrorMessage(message) {
+					metrics.IncCounter(MetricErrors, map[string]string{"action": call.Action, "reason": strconv.Itoa(int(message.MsgCode))})
+				}
+			}
+			if err != nil {
+				metrics.IncCounter(MetricErrors, map[string]string{"action": call.Action, "reason": errorReason(err)})
+			}
+		}
+		return err
+	}
+}
+
+func errorReason(err error) string {
+	var fault *SOAPFault
+	if errors.As(err, &fault) {
+		return "soap_fault"
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return fmt.Sprintf("http_%d", httpErr.StatusCode)
+	}
+	return "transport"
+}
+
+// apiVersion finds ServerInfo.ApiVersion in the UserSessionVO carried by a
+// request or response, if any.
+func apiVersion(v interface{}) string {
+	value := reflect.ValueOf(v)
+	for depth := 0; depth < 3; depth++ {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return ""
+			}
+			value = value.Elem()
+		}
+		if value.Kind() != reflect.Struct {
+			return ""
+		}
+		if session, ok := value.Interface().(UserSessionVO); ok {
+			if session.ServerInfo != nil {
+				return session.ServerInfo.ApiVersion
+			}
+			return ""
+		}
+		if field := value.FieldByName("UserSessionVO"); field.IsValid() {
+			value = field
+		} else if field := value.FieldByName("Return_"); field.IsValid() {
+			value = field
+		} else {
+			return ""
+		}
+	}
+	return ""
+}
+
+// startSpan starts a child span when the client has a tracer.
+func (s *SOAPClient) startSpan(ctx context.Context, name string) (context.Context, func(error)) {
+	if s.tracer == nil {
+		return ctx, func(error) {}
+	}
+	ctx, span := s.tracer.Start(ctx, name)
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}
+}
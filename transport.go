@@ -0,0 +1,73 @@
+package gopowerschool
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Transport carries calls to the PowerSchool service. SOAPClient and
+// JSONClient implement it.
+type Transport interface {
+	Call(soapAction string, request, response interface{}) error
+	CallContext(ctx context.Context, soapAction string, request, response interface{}) error
+	// URL is the service endpoint the transport posts to.
+	URL() string
+}
+
+// NewServiceWithTransport returns a client that makes its calls through
+// transport.
+func NewServiceWithTransport(transport Transport) *PublicPortalServiceJSONPortType {
+	return &PublicPortalServiceJSONPortType{client: transport}
+}
+
+// Encoding is the wire format used for calls.
+type Encoding int
+
+const (
+	// EncodingXML sends SOAP envelopes. It is the default.
+	EncodingXML Encoding = iota
+	// EncodingJSON sends the service's JSON encoding, in which each request
+	// and response is an object keyed by its XML element name.
+	EncodingJSON
+)
+
+// WithEncoding selects the wire format used by the client.
+func WithEncoding(encoding Encoding) ClientOption {
+	return func(c *clientConfig) error {
+		c.encoding = encoding
+		return nil
+	}
+}
+
+// wireCodec turns requests into HTTP bodies and HTTP bodies into responses.
+type wireCodec interface {
+	setHeaders(header http.Header, soapAction string)
+	encode(ctx context.Context, soapAction string, request interface{}) ([]byte, error)
+	decode(r io.Reader, call *SOAPCall) (*SOAPFault, error)
+}
+
+func (s *SOAPClient) wireCodec() wireCodec {
+	if s.codec == nil {
+		return &xmlCodec{}
+	}
+	return s.codec
+}
+
+func (s *SOAPClient) URL() string {
+	return s.url
+}
+
+// httpTransporter is implemented by transports that make their calls over
+// an http.RoundTripper other requests to the server should share, such as
+// SOAPClient and JSONClient.
+type httpTransporter interface {
+	httpTransport() http.RoundTripper
+}
+
+func (s *SOAPClient) httpTransport() http.RoundTripper {
+	if s.httpClient == nil || s.httpClient.Transport == nil {
+		return http.DefaultTransport
+	}
+	return s.httpClient.Transport
+}
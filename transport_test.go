@@ -0,0 +1,137 @@
+package gopowerschool
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestXMLTransport(t *testing.T) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if got := r.Header.Get("SOAPAction"); got != "urn:getStudentData" {
+			t.Errorf("SOAPAction = %q", got)
+		}
+		if got := r.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/xml") {
+			t.Errorf("Content-Type = %q", got)
+		}
+		if !strings.Contains(string(body), "<studentIDs>7</studentIDs>") {
+			t.Errorf("request does not name the student:\n%s", body)
+		}
+		io.WriteString(w, soapResponse("getStudentData", "<studentDataVOs><studentId>7</studentId><student><firstName>Ada</firstName></student></studentDataVOs>"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := client.GetStudentData(&GetStudentData{StudentIDs: []int64{7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	students := response.Return_.StudentDataVOs
+	if len(students) != 1 || students[0].StudentId != 7 || students[0].Student.FirstName != "Ada" {
+		t.Errorf("decoded %+v", students)
+	}
+}
+
+func TestJSONTransport(t *testing.T) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		if got := r.Header.Get("Content-Type"); !strings.HasPrefix(got, "application/json") {
+			t.Errorf("Content-Type = %q", got)
+		}
+		var request map[string]GetStudentData
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Errorf("request %s: %v", body, err)
+		}
+		if ids := request["getStudentData"].StudentIDs; len(ids) != 1 || ids[0] != 7 {
+			t.Errorf("request does not name the student: %s", body)
+		}
+		io.WriteString(w, `{"getStudentDataResponse": {"return": {"studentDataVOs": [{"studentId": 7, "student": {"firstName": "Ada"}}]}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithEncoding(EncodingJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := client.GetStudentData(&GetStudentData{StudentIDs: []int64{7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	students := response.Return_.StudentDataVOs
+	if len(students) != 1 || students[0].StudentId != 7 || students[0].Student.FirstName != "Ada" {
+		t.Errorf("decoded %+v", students)
+	}
+}
+
+func TestJSONTransportFault(t *testing.T) {
+	server := httptest.NewServer(serviceHandler(t, func(w http.ResponseWriter, r *http.Request, body []byte) {
+		io.WriteString(w, `{"Fault": {"faultcode": "soapenv:Server", "faultstring": "session expired"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithEncoding(EncodingJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetStudentData(&GetStudentData{})
+	if fault, ok := err.(*SOAPFault); !ok || fault.String != "session expired" {
+		t.Errorf("call returned %v, want the fault", err)
+	}
+}
+
+func TestVOJSONRoundTrip(t *testing.T) {
+	results := &ResultsVO{
+		MessageVOs: []*MessageVO{{Title: "Notice", Description: "Grades are final"}},
+		StudentDataVOs: []*StudentDataVO{{
+			StudentId:   7,
+			Student:     &StudentVO{FirstName: "Ada", LastName: "Lovelace", GradeLevel: 11, CurrentMealBalance: 12.5},
+			Schools:     []*SchoolVO{{SchoolId: 3, Name: "Analytical High"}},
+			Sections:    []*SectionVO{{Id: 40, CourseCode: "MATH401", Description: "Calculus"}},
+			FinalGrades: []*FinalGradeVO{{Id: 1, Sectionid: 40, Grade: "A", Percent: 97.5}},
+		}},
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(ResultsVO)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, results) {
+		t.Errorf("round trip through %s changed the value", data)
+	}
+}
+
+// VOs decoded from a response carry the vo namespace; sent back in a
+// request they must take the namespace of the request element.
+func TestDecodedVOsAreSentInRequestNamespace(t *testing.T) {
+	const reply = `<ns:getStudentDataResponse xmlns:ns="http://publicportal.rest.powerschool.pearson.com/xsd" xmlns:ax="http://vo.rest.powerschool.pearson.com/xsd"><ns:return>` +
+		`<ax:userSessionVO><ax:serviceTicket>ticket</ax:serviceTicket></ax:userSessionVO>` +
+		`<ax:studentDataVOs><ax:notificationSettingsVO><ax:emailAddresses>a@example.com</ax:emailAddresses></ax:notificationSettingsVO></ax:studentDataVOs>` +
+		`</ns:return></ns:getStudentDataResponse>`
+	response := new(GetStudentDataResponse)
+	if err := xml.Unmarshal([]byte(reply), response); err != nil {
+		t.Fatal(err)
+	}
+	session := response.Return_.UserSessionVO
+	settings := response.Return_.StudentDataVOs[0].NotificationSettingsVO
+
+	data, err := xml.Marshal(&StoreNotificationSettings{UserSessionVO: session, Ns: settings})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<storeNotificationSettings xmlns="http://publicportal.rest.powerschool.pearson.com/xsd">` +
+		`<userSessionVO><serviceTicket>ticket</serviceTicket></userSessionVO>` +
+		`<ns><emailAddresses>a@example.com</emailAddresses></ns></storeNotificationSettings>`
+	if string(data) != want {
+		t.Errorf("encoded\n%s\nwant\n%s", data, want)
+	}
+}
@@ -0,0 +1,25 @@
+package gopowerschool
+
+import "fmt"
+
+// UserType is the kind of account a call is made for, as sent in the
+// userType parameter and returned in UserSessionVO.UserType.
+type UserType int32
+
+const (
+	UserTypeGuardian UserType = 1
+	UserTypeStudent  UserType = 2
+	UserTypeTeacher  UserType = 3
+)
+
+func (t UserType) String() string {
+	switch t {
+	case UserTypeGuardian:
+		return "guardian"
+	case UserTypeStudent:
+		return "student"
+	case UserTypeTeacher:
+		return "teacher"
+	}
+	return fmt.Sprintf("UserType(%d)", int32(t))
+}